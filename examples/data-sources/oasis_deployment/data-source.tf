@@ -0,0 +1,31 @@
+terraform {
+  required_version = ">= 0.13.0"
+  required_providers {
+    oasis = {
+      source  = "arangodb-managed/oasis"
+      version = ">=2.1.8"
+    }
+  }
+}
+
+provider "oasis" {
+  api_key_id     = "" // API Key ID generated in Oasis platform
+  api_key_secret = "" // API Key Secret generated in Oasis platform
+  organization   = "" // Your Oasis organization where you want to create the resources
+}
+
+// Load in an existing deployment by its ID
+data "oasis_deployment" "by_id" {
+  id = "" // deployment id
+}
+
+// Load in an existing deployment by its name in a project
+data "oasis_deployment" "by_name" {
+  project = "" // project id, defaults to the project of the provider
+  name    = "production"
+}
+
+// Output the endpoint of the deployment
+output "endpoint" {
+  value = data.oasis_deployment.by_name.endpoint_default
+}
@@ -0,0 +1,44 @@
+terraform {
+  required_version = ">= 1.2.0"
+  required_providers {
+    oasis = {
+      source  = "arangodb-managed/oasis"
+      version = ">=2.1.8"
+    }
+  }
+}
+
+provider "oasis" {
+  api_key_id     = "" // API Key ID generated in Oasis platform
+  api_key_secret = "" // API Key Secret generated in Oasis platform
+  organization   = "" // Your Oasis organization where you want to create the resources
+}
+
+// Calculate the price of a deployment configuration at plan time
+data "oasis_deployment_price" "price" {
+  project = "" // project id, defaults to the project of the provider
+  location {
+    region = "gcp-europe-west4"
+  }
+  configuration {
+    model          = "oneshard"
+    node_size_id   = "c4-a8"
+    node_count     = 3
+    node_disk_size = 20
+  }
+}
+
+variable "monthly_budget" {
+  type    = number
+  default = 1000
+}
+
+// Fail the plan if the deployment exceeds the budget
+output "price_per_month" {
+  value = "${data.oasis_deployment_price.price.price_per_month} ${data.oasis_deployment_price.price.currency_id}"
+
+  precondition {
+    condition     = data.oasis_deployment_price.price.price_per_month <= var.monthly_budget
+    error_message = "The deployment exceeds the monthly budget."
+  }
+}
@@ -0,0 +1,28 @@
+terraform {
+  required_version = ">= 0.13.0"
+  required_providers {
+    oasis = {
+      source  = "arangodb-managed/oasis"
+      version = ">=2.1.8"
+    }
+  }
+}
+
+provider "oasis" {
+  api_key_id     = "" // API Key ID generated in Oasis platform
+  api_key_secret = "" // API Key Secret generated in Oasis platform
+  organization   = "" // Your Oasis organization where you want to create the resources
+}
+
+// Load in all OneShard deployments of a project in a region whose name starts with "prod-"
+data "oasis_deployments" "production" {
+  project    = "" // project id, defaults to the project of the provider
+  region     = "gcp-europe-west4"
+  model      = "oneshard"
+  name_regex = "^prod-"
+}
+
+// Output the endpoints of the deployments by name
+output "endpoints" {
+  value = { for depl in data.oasis_deployments.production.deployments : depl.name => depl.endpoint_default }
+}
@@ -0,0 +1,37 @@
+terraform {
+  required_version = ">= 1.2.0"
+  required_providers {
+    oasis = {
+      source  = "arangodb-managed/oasis"
+      version = ">=2.1.8"
+    }
+  }
+}
+
+provider "oasis" {
+  api_key_id     = "" // API Key ID generated in Oasis platform
+  api_key_secret = "" // API Key Secret generated in Oasis platform
+  organization   = "" // Your Oasis organization where you want to create the resources
+}
+
+// Load in all the node sizes available for a model in a region
+data "oasis_node_sizes" "sizes" {
+  project = "" // project id, defaults to the project of the provider
+  region  = "gcp-europe-west4"
+  model   = "oneshard"
+}
+
+variable "node_size_id" {
+  type    = string
+  default = "c4-a8"
+}
+
+// Validate an input against the available node sizes
+output "node_size" {
+  value = one([for size in data.oasis_node_sizes.sizes.items : size if size.id == var.node_size_id])
+
+  precondition {
+    condition     = contains(data.oasis_node_sizes.sizes.items[*].id, var.node_size_id)
+    error_message = "The node size is not available for the model in the region."
+  }
+}
@@ -0,0 +1,28 @@
+terraform {
+  required_version = ">= 0.13.0"
+  required_providers {
+    oasis = {
+      source  = "arangodb-managed/oasis"
+      version = ">=2.1.8"
+    }
+  }
+}
+
+provider "oasis" {
+  api_key_id     = "" // API Key ID generated in Oasis platform
+  api_key_secret = "" // API Key Secret generated in Oasis platform
+  organization   = "" // Your Oasis organization where you want to create the resources
+}
+
+// Load in all the available ArangoDB versions
+data "oasis_versions" "versions" {}
+
+// Output the default version, which can be used to pin the version of a deployment
+output "default_version" {
+  value = data.oasis_versions.versions.default_version
+}
+
+// Output the versions which are no longer actively supported
+output "end_of_life_versions" {
+  value = [for v in data.oasis_versions.versions.items : v.version if v.is_end_of_life]
+}
@@ -0,0 +1,2 @@
+# Audit logs can be imported using their ID
+terraform import oasis_auditlog.my_auditlog <auditlog-id>
@@ -0,0 +1,3 @@
+# Backups can be imported using their ID
+# The auto_deleted_at field cannot be read back and is not set
+terraform import oasis_backup.my_backup <backup-id>
@@ -0,0 +1,2 @@
+# Backup policies can be imported using their ID
+terraform import oasis_backup_policy.my_backup_policy <backup-policy-id>
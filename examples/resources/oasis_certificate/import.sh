@@ -0,0 +1,2 @@
+# CA certificates can be imported using their ID
+terraform import oasis_certificate.my_certificate <certificate-id>
@@ -0,0 +1,2 @@
+# Deployments can be imported using their ID
+terraform import oasis_deployment.my_deployment <deployment-id>
@@ -0,0 +1,2 @@
+# Example dataset installations can be imported using their ID
+terraform import oasis_example_dataset_installation.my_example_dataset_installation <example-dataset-installation-id>
@@ -0,0 +1,2 @@
+# IAM groups can be imported using their ID
+terraform import oasis_iam_group.my_iam_group <iam-group-id>
@@ -0,0 +1,2 @@
+# IAM policies can be imported using the URL of the resource they apply to
+terraform import oasis_iam_policy.my_iam_policy /Organization/<organization-id>
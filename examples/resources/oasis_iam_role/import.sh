@@ -0,0 +1,2 @@
+# IAM roles can be imported using their ID
+terraform import oasis_iam_role.my_iam_role <iam-role-id>
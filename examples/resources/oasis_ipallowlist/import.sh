@@ -0,0 +1,2 @@
+# IP allowlists can be imported using their ID
+terraform import oasis_ipallowlist.my_ipallowlist <ipallowlist-id>
@@ -0,0 +1,2 @@
+# Metrics tokens can be imported using their ID, the token itself is only known to the state which created it
+terraform import oasis_metrics_token.my_metrics_token <metrics-token-id>
@@ -0,0 +1,58 @@
+terraform {
+  required_version = ">= 0.13.0"
+  required_providers {
+    oasis = {
+      source  = "arangodb-managed/oasis"
+      version = ">=2.1.8"
+    }
+  }
+}
+
+provider "oasis" {
+  api_key_id     = "" // API Key ID generated in Oasis platform
+  api_key_secret = "" // API Key Secret generated in Oasis platform
+  organization   = "" // Your Oasis organization where you want to create the resources
+  project        = "" // Your Oasis project where you want to create the resources
+}
+
+// Terraform created deployment
+resource "oasis_deployment" "my_oneshard_deployment" {
+  terms_and_conditions_accepted = "true"
+  name                          = "oasis_test_deployment_terraform"
+  description                   = "Terraform Generated Deployment"
+  location {
+    region = "gcp-europe-west4"
+  }
+  configuration {
+    model = "oneshard"
+  }
+}
+
+// Metrics token to scrape the metrics of the deployment, valid for 30 days
+resource "oasis_metrics_token" "my_metrics_token" {
+  name        = "Prometheus"
+  description = "Terraform generated metrics token"
+  deployment  = oasis_deployment.my_oneshard_deployment.id
+  lifetime    = 2592000
+}
+
+// Scheme, host and path of the metrics endpoint
+locals {
+  metrics_url = regex("^(?P<scheme>https?)://(?P<host>[^/]+)(?P<path>/.*)?$", oasis_metrics_token.my_metrics_token.endpoint_default)
+}
+
+// Prometheus scrape configuration of the deployment
+output "prometheus_scrape_config" {
+  sensitive = true
+  value = yamlencode({
+    scrape_configs = [{
+      job_name       = "oasis-${oasis_deployment.my_oneshard_deployment.name}"
+      scheme         = local.metrics_url.scheme
+      metrics_path   = coalesce(local.metrics_url.path, "/metrics")
+      static_configs = [{ targets = [local.metrics_url.host] }]
+      authorization = {
+        credentials = oasis_metrics_token.my_metrics_token.token
+      }
+    }]
+  })
+}
@@ -0,0 +1,2 @@
+# Multi region backups can be imported using the ID of the copied backup
+terraform import oasis_multi_region_backup.my_multi_region_backup <backup-id>
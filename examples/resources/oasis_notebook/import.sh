@@ -0,0 +1,2 @@
+# Notebooks can be imported using their ID
+terraform import oasis_notebook.my_notebook <notebook-id>
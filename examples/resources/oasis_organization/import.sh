@@ -0,0 +1,2 @@
+# Organizations can be imported using their ID
+terraform import oasis_organization.my_organization <organization-id>
@@ -0,0 +1,5 @@
+# Organization invites can be imported using their ID
+terraform import oasis_organization_invite.my_organization_invite <organization-invite-id>
+
+# or using the ID of the organization and the invited email address
+terraform import oasis_organization_invite.my_organization_invite <organization-id>/<email>
@@ -0,0 +1,2 @@
+# Private endpoint services can be imported using their ID
+terraform import oasis_private_endpoint.my_private_endpoint <private-endpoint-id>
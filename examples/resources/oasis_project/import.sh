@@ -0,0 +1,2 @@
+# Projects can be imported using their ID
+terraform import oasis_project.my_project <project-id>
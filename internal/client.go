@@ -23,78 +23,231 @@ package provider
 import (
 	"context"
 	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"os"
+	"sync"
+	"time"
 
-	"github.com/rs/zerolog"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
 
 	"github.com/arangodb-managed/apis/common/auth"
 	commonGrpc "github.com/arangodb-managed/apis/common/v1/grpc"
 	iam "github.com/arangodb-managed/apis/iam/v1"
-	lh "github.com/arangodb-managed/log-helper"
 )
 
-// Client is responsible for connecting to the Arango Graph API
+const (
+	// tokenRenewalMargin is the remaining lifetime at which an auth token is renewed
+	tokenRenewalMargin = 2 * time.Minute
+	// Full method names of the IAM calls which must be made without an auth token
+	authenticateAPIKeyMethod = "/arangodb.cloud.iam.v1.IAMService/AuthenticateAPIKey"
+	renewAPIKeyTokenMethod   = "/arangodb.cloud.iam.v1.IAMService/RenewAPIKeyToken"
+)
+
+// Client is responsible for connecting to the Arango Graph API.
+// A single Client is shared by all resources of a provider instance, so it
+// connects and authenticates only once and is safe for concurrent use.
 type Client struct {
 	ApiKeyID       string
 	ApiKeySecret   string
+	AccessToken    string
 	ApiEndpoint    string
 	ApiPortSuffix  string
 	ProjectID      string
 	OrganizationID string
-	ctxWithToken   context.Context
-	conn           *grpc.ClientConn
-	log            zerolog.Logger
+	MaxRetries     int
+	// Limits of the API calls made by all resources together
+	MaxConcurrentRequests int
+	RequestsPerSecond     float64
+	// TLS settings of the API connection
+	CACertificateFile     string
+	CACertificatePEM      string
+	ClientCertificateFile string
+	ClientKeyFile         string
+	TLSServerName         string
+	InsecureSkipVerify    bool
+	Plaintext             bool
+	// ProxyURL is the HTTP proxy used to tunnel the API connection
+	ProxyURL string
+	// Alternative credential sources, see resolveCredentials
+	ApiKeyFile          string
+	CredentialsFile     string
+	Profile             string
+	credentialsResolved bool
+	// credentialsAttribute is the provider argument the credentials were resolved from
+	credentialsAttribute string
+
+	conn      *grpc.ClientConn
+	userAgent string
+	limiter   *requestLimiter
+
+	// connectMutex protects the connection state
+	connectMutex sync.Mutex
+	// tokenMutex protects the token state
+	tokenMutex     sync.Mutex
+	token          string
+	tokenExpiresAt time.Time
+	// secretsMutex protects the secret values redacted from logged errors
+	secretsMutex sync.Mutex
+	secrets      []string
 }
 
-// Connect connects to Arango Graph API
-func (c *Client) Connect() error {
-	ctx := context.Background()
-	c.log = lh.MustNew(lh.DefaultConfig())
+// Connect connects to Arango Graph API.
+// Only the first successful call dials and authenticates, subsequent calls
+// reuse the existing connection and token.
+func (c *Client) Connect(ctx context.Context) error {
+	c.connectMutex.Lock()
+	defer c.connectMutex.Unlock()
+	if c.conn != nil {
+		return nil
+	}
 
-	var err error
-	c.conn, err = c.mustDialAPI()
+	if err := c.resolveCredentials(); err != nil {
+		tflog.Error(ctx, "Invalid credentials configuration", map[string]interface{}{"error": err})
+		return err
+	}
+	c.userAgent = commonGrpc.CreateUserAgent("terraform-provider-oasis", currentVersion)
+	c.limiter = newRequestLimiter(c.MaxConcurrentRequests, c.RequestsPerSecond)
+	conn, err := c.mustDialAPI(ctx)
 	if err != nil {
 		return err
 	}
 
-	token, err := c.getToken(ctx, c.ApiKeyID, c.ApiKeySecret)
-	if err != nil {
-		c.log.Error().Err(err).Msg("Could not get Auth Token")
+	if _, err := c.getValidToken(c.apiContext(ctx), conn); err != nil {
+		tflog.Error(ctx, "Could not get Auth Token", map[string]interface{}{"error": err})
+		conn.Close()
 		return err
 	}
-	// Add Access Token
-	ctxWithToken := auth.WithAccessToken(ctx, token)
-	// Add the User Agent as well
-	ua := commonGrpc.CreateUserAgent("terraform-provider-oasis", currentVersion)
-	c.ctxWithToken = commonGrpc.WithUserAgent(ctxWithToken, ua)
+	c.conn = conn
 	return nil
 }
 
+// apiContext returns the context for calls to the Arango Graph API derived from the given
+// context of a Terraform operation, so these calls are logged with that operation.
+// The Access Token is added to every call by authInterceptor.
+func (c *Client) apiContext(ctx context.Context) context.Context {
+	ctx = tflog.NewSubsystem(ctx, apiLogSubsystem, tflog.WithLevelFromEnv(apiLogLevelEnv))
+	return commonGrpc.WithUserAgent(ctx, c.userAgent)
+}
+
 // mustDialAPI dials the Arango Graph API
-func (c *Client) mustDialAPI() (*grpc.ClientConn, error) {
+func (c *Client) mustDialAPI(ctx context.Context) (*grpc.ClientConn, error) {
+	tc, err := c.transportCredentials()
+	if err != nil {
+		tflog.Error(ctx, "Failed to configure TLS for Arango Graph API", map[string]interface{}{"error": err})
+		return nil, err
+	}
 	// Set up a connection to the server.
-	tc := credentials.NewTLS(&tls.Config{})
-	conn, err := grpc.Dial(c.ApiEndpoint+c.ApiPortSuffix, grpc.WithTransportCredentials(tc))
+	conn, err := grpc.Dial(c.ApiEndpoint+c.ApiPortSuffix,
+		grpc.WithTransportCredentials(tc),
+		grpc.WithContextDialer(c.dialAPI),
+		// The limits are applied after authentication, so the calls made by authInterceptor to obtain
+		// a token do not wait for a slot held by the call they are made for.
+		// Every retry is traced in its own span, which includes the time waiting for the limits.
+		grpc.WithChainUnaryInterceptor(c.retryInterceptor, c.authInterceptor, c.spanInterceptor, c.limitInterceptor, c.traceInterceptor),
+	)
 	if err != nil {
-		c.log.Error().Err(err).Msg("Failed to connect to Arango Graph API")
+		tflog.Error(ctx, "Failed to connect to Arango Graph API", map[string]interface{}{"error": err})
 		return nil, err
 	}
 	return conn, nil
 }
 
-func (c *Client) getToken(ctx context.Context, apiKeyID, apiKeySecret string) (string, error) {
+// transportCredentials builds the transport credentials of the API connection from the TLS settings.
+func (c *Client) transportCredentials() (credentials.TransportCredentials, error) {
+	if c.Plaintext {
+		return insecure.NewCredentials(), nil
+	}
+	tlsConfig := &tls.Config{
+		ServerName:         c.TLSServerName,
+		InsecureSkipVerify: c.InsecureSkipVerify,
+	}
 
-	iamc := iam.NewIAMServiceClient(c.conn)
+	caPEM := []byte(c.CACertificatePEM)
+	if c.CACertificateFile != "" {
+		var err error
+		if caPEM, err = os.ReadFile(c.CACertificateFile); err != nil {
+			return nil, fmt.Errorf("failed to read CA certificate file: %w", err)
+		}
+	}
+	if len(caPEM) > 0 {
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(caPEM) {
+			return nil, errors.New("failed to parse CA certificate: no valid PEM encoded certificate found")
+		}
+		tlsConfig.RootCAs = pool
+	}
 
+	if c.ClientCertificateFile != "" || c.ClientKeyFile != "" {
+		cert, err := tls.LoadX509KeyPair(c.ClientCertificateFile, c.ClientKeyFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load client certificate: %w", err)
+		}
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+	return credentials.NewTLS(tlsConfig), nil
+}
+
+// authInterceptor adds a valid Access Token to every outgoing call,
+// except for the calls used to obtain that token.
+func (c *Client) authInterceptor(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+	if method != authenticateAPIKeyMethod && method != renewAPIKeyTokenMethod {
+		token, err := c.getValidToken(ctx, cc)
+		if err != nil {
+			return err
+		}
+		ctx = auth.WithAccessToken(ctx, token)
+	}
+	return invoker(ctx, method, req, reply, cc, opts...)
+}
+
+// getValidToken returns the cached Access Token, renewing it when it is about to expire
+// or authenticating again when it cannot be renewed.
+// A static Access Token is returned as is.
+func (c *Client) getValidToken(ctx context.Context, conn *grpc.ClientConn) (string, error) {
+	if c.AccessToken != "" {
+		return c.AccessToken, nil
+	}
+	c.tokenMutex.Lock()
+	defer c.tokenMutex.Unlock()
+
+	if c.token != "" && time.Until(c.tokenExpiresAt) > tokenRenewalMargin {
+		return c.token, nil
+	}
+	iamc := iam.NewIAMServiceClient(conn)
+	if c.token != "" {
+		resp, err := iamc.RenewAPIKeyToken(ctx, &iam.RenewAPIKeyTokenRequest{Token: c.token})
+		if err == nil {
+			c.tokenExpiresAt = time.Now().Add(resp.GetTimeToLive().AsDuration())
+			tflog.Debug(ctx, "Renewed Auth token successfully.")
+			return c.token, nil
+		}
+		tflog.Warn(ctx, "Failed to renew Auth token, authenticating again", map[string]interface{}{"error": err})
+	}
+	token, ttl, err := c.getToken(ctx, iamc, c.ApiKeyID, c.ApiKeySecret)
+	if err != nil {
+		c.token = ""
+		return "", err
+	}
+	c.registerSecret(token)
+	c.token = token
+	c.tokenExpiresAt = time.Now().Add(ttl)
+	return token, nil
+}
+
+func (c *Client) getToken(ctx context.Context, iamc iam.IAMServiceClient, apiKeyID, apiKeySecret string) (string, time.Duration, error) {
 	resp, err := iamc.AuthenticateAPIKey(ctx, &iam.AuthenticateAPIKeyRequest{
 		Id:     apiKeyID,
 		Secret: apiKeySecret,
 	})
 	if err != nil {
-		c.log.Error().Err(err).Msg("Authentication failed")
-		return "", err
+		tflog.Error(ctx, "Authentication failed", map[string]interface{}{"error": err})
+		return "", 0, err
 	}
-	c.log.Print("Retrieved Auth token successfully.")
-	return resp.GetToken(), nil
+	tflog.Debug(ctx, "Retrieved Auth token successfully.")
+	return resp.GetToken(), resp.GetTimeToLive().AsDuration(), nil
 }
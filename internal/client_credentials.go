@@ -0,0 +1,194 @@
+//
+// DISCLAIMER
+//
+// Copyright 2024 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package provider
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+const (
+	// defaultProfile is the credentials file profile used when none is selected
+	defaultProfile = "default"
+	// Credentials file profile keys
+	profileAPIKeyIDKey     = "api_key_id"
+	profileAPIKeySecretKey = "api_key_secret"
+	profileAccessTokenKey  = "access_token"
+)
+
+// defaultCredentialsFile returns the path of the credentials file used when none is configured.
+func defaultCredentialsFile() string {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return ""
+	}
+	return filepath.Join(home, ".oasis", "credentials")
+}
+
+// resolveCredentials determines the credentials used to authenticate.
+// Exactly one source must be configured, they are checked in this order:
+//  1. A static access token (access_token)
+//  2. An API key (api_key_id & api_key_secret)
+//  3. An API key file (api_key_file)
+//  4. A profile of the credentials file (credentials_file & profile)
+//
+// The default credentials file is only used implicitly when no other source is configured.
+func (c *Client) resolveCredentials() error {
+	if c.credentialsResolved {
+		return nil
+	}
+	var sources []string
+	if c.AccessToken != "" {
+		sources = append(sources, "access_token")
+	}
+	if c.ApiKeyID != "" || c.ApiKeySecret != "" {
+		if c.ApiKeyID == "" || c.ApiKeySecret == "" {
+			return errors.New("both api_key_id and api_key_secret must be set")
+		}
+		sources = append(sources, "api_key_id/api_key_secret")
+	}
+	if c.ApiKeyFile != "" {
+		sources = append(sources, "api_key_file")
+	}
+	if c.CredentialsFile != "" || c.Profile != "" {
+		sources = append(sources, "credentials_file/profile")
+	}
+	if len(sources) > 1 {
+		return fmt.Errorf("conflicting credentials configured: only one of %s can be set", strings.Join(sources, ", "))
+	}
+
+	switch {
+	case c.AccessToken != "":
+		c.credentialsAttribute = "access_token"
+	case c.ApiKeyID != "":
+		c.credentialsAttribute = "api_key_id"
+	case c.ApiKeyFile != "":
+		c.credentialsAttribute = "api_key_file"
+		if err := c.loadAPIKeyFile(c.ApiKeyFile); err != nil {
+			return err
+		}
+	default:
+		c.credentialsAttribute = "profile"
+		if c.Profile == "" {
+			c.credentialsAttribute = "credentials_file"
+		}
+		if err := c.loadProfile(); err != nil {
+			return err
+		}
+	}
+	c.credentialsResolved = true
+	return nil
+}
+
+// loadAPIKeyFile loads an API key from a JSON file containing its `id` and `secret`,
+// as returned when creating an API key.
+func (c *Client) loadAPIKeyFile(path string) error {
+	raw, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("failed to read API key file: %w", err)
+	}
+	var key struct {
+		ID     string `json:"id"`
+		Secret string `json:"secret"`
+	}
+	if err := json.Unmarshal(raw, &key); err != nil {
+		return fmt.Errorf("failed to parse API key file %s: %w", path, err)
+	}
+	if key.ID == "" || key.Secret == "" {
+		return fmt.Errorf("API key file %s must contain both id and secret", path)
+	}
+	c.ApiKeyID, c.ApiKeySecret = key.ID, key.Secret
+	return nil
+}
+
+// loadProfile loads the credentials of the selected profile from the credentials file.
+func (c *Client) loadProfile() error {
+	path := c.CredentialsFile
+	if path == "" {
+		path = defaultCredentialsFile()
+	}
+	profile := c.Profile
+	if profile == "" {
+		profile = defaultProfile
+	}
+	profiles, err := readCredentialsFile(path)
+	if err != nil {
+		if c.Profile == "" && c.CredentialsFile == "" && errors.Is(err, os.ErrNotExist) {
+			return errors.New("no credentials configured: set one of access_token, api_key_id/api_key_secret, api_key_file or profile")
+		}
+		return err
+	}
+	values, ok := profiles[profile]
+	if !ok {
+		return fmt.Errorf("profile %s not found in credentials file %s", profile, path)
+	}
+	c.AccessToken = values[profileAccessTokenKey]
+	c.ApiKeyID = values[profileAPIKeyIDKey]
+	c.ApiKeySecret = values[profileAPIKeySecretKey]
+	if c.AccessToken == "" && (c.ApiKeyID == "" || c.ApiKeySecret == "") {
+		return fmt.Errorf("profile %s in credentials file %s must contain either %s or %s and %s",
+			profile, path, profileAccessTokenKey, profileAPIKeyIDKey, profileAPIKeySecretKey)
+	}
+	return nil
+}
+
+// readCredentialsFile parses an INI style credentials file into its profiles, e.g.
+//
+//	[default]
+//	api_key_id     = ...
+//	api_key_secret = ...
+func readCredentialsFile(path string) (map[string]map[string]string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open credentials file: %w", err)
+	}
+	defer f.Close()
+
+	profiles := make(map[string]map[string]string)
+	var current map[string]string
+	scanner := bufio.NewScanner(f)
+	for lineNo := 1; scanner.Scan(); lineNo++ {
+		line := strings.TrimSpace(scanner.Text())
+		switch {
+		case line == "" || strings.HasPrefix(line, "#") || strings.HasPrefix(line, ";"):
+			continue
+		case strings.HasPrefix(line, "[") && strings.HasSuffix(line, "]"):
+			name := strings.TrimSpace(line[1 : len(line)-1])
+			current = make(map[string]string)
+			profiles[name] = current
+		default:
+			key, value, found := strings.Cut(line, "=")
+			if !found || current == nil {
+				return nil, fmt.Errorf("invalid line %d in credentials file %s", lineNo, path)
+			}
+			current[strings.TrimSpace(key)] = strings.TrimSpace(value)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read credentials file %s: %w", path, err)
+	}
+	return profiles, nil
+}
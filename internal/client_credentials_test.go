@@ -0,0 +1,109 @@
+//
+// DISCLAIMER
+//
+// Copyright 2024 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package provider
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testCredentialsFile = `
+# Oasis credentials
+[default]
+api_key_id     = default-id
+api_key_secret = default-secret
+
+[ci]
+access_token = ci-token
+
+[broken]
+api_key_id = only-id
+`
+
+// TestResolveCredentials tests the credential sources and their conflicts.
+func TestResolveCredentials(t *testing.T) {
+	dir := t.TempDir()
+	credentialsFile := filepath.Join(dir, "credentials")
+	require.NoError(t, os.WriteFile(credentialsFile, []byte(testCredentialsFile), 0600))
+	apiKeyFile := filepath.Join(dir, "apikey.json")
+	require.NoError(t, os.WriteFile(apiKeyFile, []byte(`{"id": "file-id", "secret": "file-secret"}`), 0600))
+	// Make sure no credentials file in the home directory is picked up
+	t.Setenv("HOME", dir)
+
+	t.Run("Access token", func(t *testing.T) {
+		c := &Client{AccessToken: "token"}
+		require.NoError(t, c.resolveCredentials())
+		assert.Equal(t, "token", c.AccessToken)
+	})
+	t.Run("API key", func(t *testing.T) {
+		c := &Client{ApiKeyID: "id", ApiKeySecret: "secret"}
+		require.NoError(t, c.resolveCredentials())
+		assert.Equal(t, "id", c.ApiKeyID)
+		assert.Equal(t, "secret", c.ApiKeySecret)
+	})
+	t.Run("Incomplete API key", func(t *testing.T) {
+		require.Error(t, (&Client{ApiKeyID: "id"}).resolveCredentials())
+	})
+	t.Run("API key file", func(t *testing.T) {
+		c := &Client{ApiKeyFile: apiKeyFile}
+		require.NoError(t, c.resolveCredentials())
+		assert.Equal(t, "file-id", c.ApiKeyID)
+		assert.Equal(t, "file-secret", c.ApiKeySecret)
+		// Resolving again must not report a conflict with the loaded key
+		require.NoError(t, c.resolveCredentials())
+	})
+	t.Run("Default profile", func(t *testing.T) {
+		c := &Client{CredentialsFile: credentialsFile}
+		require.NoError(t, c.resolveCredentials())
+		assert.Equal(t, "default-id", c.ApiKeyID)
+		assert.Equal(t, "default-secret", c.ApiKeySecret)
+	})
+	t.Run("Named profile", func(t *testing.T) {
+		c := &Client{CredentialsFile: credentialsFile, Profile: "ci"}
+		require.NoError(t, c.resolveCredentials())
+		assert.Equal(t, "ci-token", c.AccessToken)
+	})
+	t.Run("Incomplete profile", func(t *testing.T) {
+		require.Error(t, (&Client{CredentialsFile: credentialsFile, Profile: "broken"}).resolveCredentials())
+	})
+	t.Run("Unknown profile", func(t *testing.T) {
+		require.Error(t, (&Client{CredentialsFile: credentialsFile, Profile: "unknown"}).resolveCredentials())
+	})
+	t.Run("None", func(t *testing.T) {
+		err := (&Client{}).resolveCredentials()
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "no credentials configured")
+	})
+	t.Run("Conflict", func(t *testing.T) {
+		err := (&Client{AccessToken: "token", ApiKeyFile: apiKeyFile}).resolveCredentials()
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "conflicting credentials")
+	})
+	t.Run("Conflict with credentials file", func(t *testing.T) {
+		err := (&Client{ApiKeyID: "id", ApiKeySecret: "secret", CredentialsFile: credentialsFile}).resolveCredentials()
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "credentials_file")
+	})
+}
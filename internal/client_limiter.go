@@ -0,0 +1,101 @@
+//
+// DISCLAIMER
+//
+// Copyright 2024 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package provider
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc"
+)
+
+// requestLimiter limits the number of concurrent API calls and the rate at which they are started.
+type requestLimiter struct {
+	// slots holds a token per running call, nil when concurrency is unlimited
+	slots chan struct{}
+	// interval is the minimum time between the start of two calls, 0 when the rate is unlimited
+	interval time.Duration
+
+	mutex sync.Mutex
+	next  time.Time
+}
+
+// newRequestLimiter creates a limiter for the given maximum number of concurrent calls
+// and calls per second. A value of 0 disables the respective limit.
+func newRequestLimiter(maxConcurrent int, perSecond float64) *requestLimiter {
+	l := &requestLimiter{}
+	if maxConcurrent > 0 {
+		l.slots = make(chan struct{}, maxConcurrent)
+	}
+	if perSecond > 0 {
+		l.interval = time.Duration(float64(time.Second) / perSecond)
+	}
+	return l
+}
+
+// acquire waits until a call may be started, or the given context is done.
+// Every successful acquire must be followed by a release.
+func (l *requestLimiter) acquire(ctx context.Context) error {
+	if l.slots != nil {
+		select {
+		case l.slots <- struct{}{}:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+	if l.interval > 0 {
+		l.mutex.Lock()
+		now := time.Now()
+		if l.next.Before(now) {
+			l.next = now
+		}
+		wait := l.next.Sub(now)
+		l.next = l.next.Add(l.interval)
+		l.mutex.Unlock()
+
+		if wait > 0 {
+			select {
+			case <-time.After(wait):
+			case <-ctx.Done():
+				l.release()
+				return ctx.Err()
+			}
+		}
+	}
+	return nil
+}
+
+// release frees the slot taken by acquire.
+func (l *requestLimiter) release() {
+	if l.slots != nil {
+		<-l.slots
+	}
+}
+
+// limitInterceptor applies the request limits of the client to every call.
+func (c *Client) limitInterceptor(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+	if err := c.limiter.acquire(ctx); err != nil {
+		return err
+	}
+	defer c.limiter.release()
+	return invoker(ctx, method, req, reply, cc, opts...)
+}
@@ -0,0 +1,89 @@
+//
+// DISCLAIMER
+//
+// Copyright 2024 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package provider
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestRequestLimiterConcurrency tests that no more than the maximum number of calls run at once.
+func TestRequestLimiterConcurrency(t *testing.T) {
+	l := newRequestLimiter(2, 0)
+	var running, maxRunning int32
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			require.NoError(t, l.acquire(context.Background()))
+			defer l.release()
+			n := atomic.AddInt32(&running, 1)
+			for {
+				m := atomic.LoadInt32(&maxRunning)
+				if n <= m || atomic.CompareAndSwapInt32(&maxRunning, m, n) {
+					break
+				}
+			}
+			time.Sleep(5 * time.Millisecond)
+			atomic.AddInt32(&running, -1)
+		}()
+	}
+	wg.Wait()
+	assert.LessOrEqual(t, maxRunning, int32(2))
+}
+
+// TestRequestLimiterRate tests that calls are spread according to the rate limit.
+func TestRequestLimiterRate(t *testing.T) {
+	l := newRequestLimiter(0, 100)
+	start := time.Now()
+	for i := 0; i < 5; i++ {
+		require.NoError(t, l.acquire(context.Background()))
+		l.release()
+	}
+	// The first call starts immediately, the other 4 are spaced by 10ms
+	assert.GreaterOrEqual(t, time.Since(start), 40*time.Millisecond)
+}
+
+// TestRequestLimiterCancel tests that waiting for a slot stops when the context is done.
+func TestRequestLimiterCancel(t *testing.T) {
+	l := newRequestLimiter(1, 0)
+	require.NoError(t, l.acquire(context.Background()))
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	assert.ErrorIs(t, l.acquire(ctx), context.DeadlineExceeded)
+	l.release()
+	require.NoError(t, l.acquire(context.Background()))
+}
+
+// TestRequestLimiterUnlimited tests that a limiter without limits never blocks.
+func TestRequestLimiterUnlimited(t *testing.T) {
+	l := newRequestLimiter(0, 0)
+	for i := 0; i < 100; i++ {
+		require.NoError(t, l.acquire(context.Background()))
+	}
+}
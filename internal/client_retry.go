@@ -0,0 +1,112 @@
+//
+// DISCLAIMER
+//
+// Copyright 2024 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package provider
+
+import (
+	"context"
+	"math/rand"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// retryInitialBackoff is the backoff before the first retry
+	retryInitialBackoff = 500 * time.Millisecond
+	// retryMaxBackoff is the upper bound of the backoff between two retries
+	retryMaxBackoff = 30 * time.Second
+)
+
+// isRetryableError returns true if the given error is a transient gRPC failure
+// for which the call can be repeated.
+func isRetryableError(err error) bool {
+	switch status.Code(err) {
+	case codes.Unavailable, codes.ResourceExhausted, codes.DeadlineExceeded:
+		return true
+	default:
+		return false
+	}
+}
+
+// readOnlyMethodPrefixes are the prefixes of the names of the API methods which do not change anything
+var readOnlyMethodPrefixes = []string{"Get", "List", "Calculate"}
+
+// isReadOnlyMethod returns true if the given full gRPC method name refers to a method which does not change anything.
+// Only these methods are retried: a failed mutation may have been applied anyway, so repeating it
+// could for example create a deployment twice.
+func isReadOnlyMethod(method string) bool {
+	if method == authenticateAPIKeyMethod {
+		return true
+	}
+	name := method[strings.LastIndex(method, "/")+1:]
+	for _, prefix := range readOnlyMethodPrefixes {
+		if strings.HasPrefix(name, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// retryBackoff returns the time to wait before the given retry attempt (starting at 1),
+// using exponential backoff with full jitter.
+func retryBackoff(attempt int) time.Duration {
+	backoff := retryInitialBackoff
+	for i := 1; i < attempt && backoff < retryMaxBackoff; i++ {
+		backoff *= 2
+	}
+	if backoff > retryMaxBackoff {
+		backoff = retryMaxBackoff
+	}
+	return time.Duration(rand.Int63n(int64(backoff) + 1))
+}
+
+// retryInterceptor repeats calls of read-only methods failing with a retryable error up to MaxRetries times.
+func (c *Client) retryInterceptor(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+	err := invoker(ctx, method, req, reply, cc, opts...)
+	if !isReadOnlyMethod(method) {
+		return err
+	}
+	for attempt := 1; attempt <= c.MaxRetries && isRetryableError(err); attempt++ {
+		if ctx.Err() != nil {
+			// The caller gave up, retrying makes no sense
+			return err
+		}
+		backoff := retryBackoff(attempt)
+		tflog.SubsystemWarn(ctx, apiLogSubsystem, "Retrying failed call to Arango Graph API", map[string]interface{}{
+			"error":       err,
+			"method":      method,
+			"attempt":     attempt,
+			"max-retries": c.MaxRetries,
+			"backoff":     backoff.String(),
+		})
+		select {
+		case <-time.After(backoff):
+		case <-ctx.Done():
+			return err
+		}
+		err = invoker(ctx, method, req, reply, cc, opts...)
+	}
+	return err
+}
@@ -0,0 +1,107 @@
+//
+// DISCLAIMER
+//
+// Copyright 2024 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// TestIsRetryableError tests the classification of gRPC errors.
+func TestIsRetryableError(t *testing.T) {
+	assert.True(t, isRetryableError(status.Error(codes.Unavailable, "unavailable")))
+	assert.True(t, isRetryableError(status.Error(codes.ResourceExhausted, "throttled")))
+	assert.True(t, isRetryableError(status.Error(codes.DeadlineExceeded, "too slow")))
+	assert.False(t, isRetryableError(status.Error(codes.NotFound, "not found")))
+	assert.False(t, isRetryableError(status.Error(codes.InvalidArgument, "invalid")))
+	assert.False(t, isRetryableError(errors.New("plain error")))
+	assert.False(t, isRetryableError(nil))
+}
+
+// TestIsReadOnlyMethod tests the classification of API methods.
+func TestIsReadOnlyMethod(t *testing.T) {
+	assert.True(t, isReadOnlyMethod("/arangodb.cloud.data.v1.DataService/GetDeployment"))
+	assert.True(t, isReadOnlyMethod("/arangodb.cloud.data.v1.DataService/ListNodeSizes"))
+	assert.True(t, isReadOnlyMethod("/arangodb.cloud.data.v1.DataService/CalculateDeploymentPrice"))
+	assert.True(t, isReadOnlyMethod("/arangodb.cloud.iam.v1.IAMService/GetThisUser"))
+	assert.True(t, isReadOnlyMethod(authenticateAPIKeyMethod))
+	assert.False(t, isReadOnlyMethod("/arangodb.cloud.data.v1.DataService/CreateDeployment"))
+	assert.False(t, isReadOnlyMethod("/arangodb.cloud.data.v1.DataService/UpdateDeployment"))
+	assert.False(t, isReadOnlyMethod("/arangodb.cloud.replication.v1.ReplicationService/CloneDeploymentFromBackup"))
+	assert.False(t, isReadOnlyMethod("/arangodb.cloud.iam.v1.IAMService/AddRoleBindings"))
+}
+
+// TestRetryBackoff tests that the backoff stays within its bounds.
+func TestRetryBackoff(t *testing.T) {
+	for attempt := 1; attempt < 20; attempt++ {
+		backoff := retryBackoff(attempt)
+		assert.GreaterOrEqual(t, int64(backoff), int64(0))
+		assert.LessOrEqual(t, backoff, retryMaxBackoff)
+	}
+}
+
+// TestRetryInterceptor tests that retryable errors of read-only methods are retried up to the configured limit.
+func TestRetryInterceptor(t *testing.T) {
+	calls := 0
+	invoker := func(code codes.Code) grpc.UnaryInvoker {
+		return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, opts ...grpc.CallOption) error {
+			calls++
+			return status.Error(code, "failure")
+		}
+	}
+
+	client := &Client{MaxRetries: 2}
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	// A cancelled context stops retrying
+	calls = 0
+	err := client.retryInterceptor(ctx, "/arangodb.cloud.data.v1.DataService/GetDeployment", nil, nil, nil, invoker(codes.Unavailable))
+	assert.Equal(t, codes.Unavailable, status.Code(err))
+	assert.Equal(t, 1, calls)
+
+	// Non retryable errors are returned immediately
+	calls = 0
+	err = client.retryInterceptor(context.Background(), "/arangodb.cloud.data.v1.DataService/GetDeployment", nil, nil, nil, invoker(codes.NotFound))
+	assert.Equal(t, codes.NotFound, status.Code(err))
+	assert.Equal(t, 1, calls)
+
+	// Retryable errors are retried
+	calls = 0
+	client.MaxRetries = 1
+	err = client.retryInterceptor(context.Background(), "/arangodb.cloud.data.v1.DataService/GetDeployment", nil, nil, nil, invoker(codes.ResourceExhausted))
+	assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+	assert.Equal(t, 2, calls)
+
+	// Mutations are never retried, since they may have been applied
+	for _, code := range []codes.Code{codes.Unavailable, codes.ResourceExhausted, codes.DeadlineExceeded} {
+		calls = 0
+		err = client.retryInterceptor(context.Background(), "/arangodb.cloud.data.v1.DataService/CreateDeployment", nil, nil, nil, invoker(code))
+		assert.Equal(t, code, status.Code(err))
+		assert.Equal(t, 1, calls)
+	}
+}
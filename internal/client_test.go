@@ -0,0 +1,117 @@
+//
+// DISCLAIMER
+//
+// Copyright 2024 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package provider
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestClientReusesValidToken tests that a cached token is reused without contacting the API.
+func TestClientReusesValidToken(t *testing.T) {
+	client := &Client{
+		token:          "cached-token",
+		tokenExpiresAt: time.Now().Add(time.Hour),
+	}
+	// A nil connection would panic if the API was contacted
+	token, err := client.getValidToken(context.Background(), nil)
+	require.NoError(t, err)
+	assert.Equal(t, "cached-token", token)
+}
+
+// TestClientConnectIsNoopWhenConnected tests that Connect does not dial again once connected.
+func TestClientConnectIsNoopWhenConnected(t *testing.T) {
+	conn, err := (&Client{ApiEndpoint: "localhost", ApiPortSuffix: ":0"}).mustDialAPI(context.Background())
+	require.NoError(t, err)
+	defer conn.Close()
+
+	client := &Client{conn: conn}
+	require.NoError(t, client.Connect(context.Background()))
+	assert.Same(t, conn, client.conn)
+}
+
+// TestClientTransportCredentials tests the TLS settings of the API connection.
+func TestClientTransportCredentials(t *testing.T) {
+	caPEM := generateTestCertificatePEM(t)
+
+	t.Run("Default", func(t *testing.T) {
+		tc, err := (&Client{}).transportCredentials()
+		require.NoError(t, err)
+		assert.Equal(t, "tls", tc.Info().SecurityProtocol)
+	})
+	t.Run("Plaintext", func(t *testing.T) {
+		tc, err := (&Client{Plaintext: true}).transportCredentials()
+		require.NoError(t, err)
+		assert.Equal(t, "insecure", tc.Info().SecurityProtocol)
+	})
+	t.Run("CA certificate PEM", func(t *testing.T) {
+		_, err := (&Client{CACertificatePEM: string(caPEM), TLSServerName: "api.test"}).transportCredentials()
+		require.NoError(t, err)
+	})
+	t.Run("CA certificate file", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "ca.pem")
+		require.NoError(t, os.WriteFile(path, caPEM, 0600))
+		_, err := (&Client{CACertificateFile: path}).transportCredentials()
+		require.NoError(t, err)
+	})
+	t.Run("Invalid CA certificate", func(t *testing.T) {
+		_, err := (&Client{CACertificatePEM: "not a certificate"}).transportCredentials()
+		require.Error(t, err)
+	})
+	t.Run("Missing CA certificate file", func(t *testing.T) {
+		_, err := (&Client{CACertificateFile: filepath.Join(t.TempDir(), "missing.pem")}).transportCredentials()
+		require.Error(t, err)
+	})
+	t.Run("Missing client key", func(t *testing.T) {
+		_, err := (&Client{ClientCertificateFile: "cert.pem"}).transportCredentials()
+		require.Error(t, err)
+	})
+}
+
+// generateTestCertificatePEM creates a PEM encoded self signed certificate.
+func generateTestCertificatePEM(t *testing.T) []byte {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "api.test"},
+		NotBefore:             time.Now(),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+}
@@ -24,6 +24,7 @@ import (
 	"context"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
@@ -92,16 +93,16 @@ func dataSourceOasisBackup() *schema.Resource {
 // dataSourceOasisBackupRead reloads the resource object from the Terraform store.
 func dataSourceOasisBackupRead(ctx context.Context, data *schema.ResourceData, m interface{}) diag.Diagnostics {
 	client := m.(*Client)
-	if err := client.Connect(); err != nil {
-		client.log.Error().Err(err).Msg("Failed to connect to api")
+	if err := client.Connect(ctx); err != nil {
+		tflog.Error(ctx, "Failed to connect to api", map[string]interface{}{"error": err})
 		return diag.FromErr(err)
 	}
 
 	backupc := backup.NewBackupServiceClient(client.conn)
 	bid := data.Get(backupDataSourceIdFieldName).(string)
-	backup, err := backupc.GetBackup(client.ctxWithToken, &common.IDOptions{Id: bid})
+	backup, err := backupc.GetBackup(client.apiContext(ctx), &common.IDOptions{Id: bid})
 	if err != nil || backup == nil {
-		client.log.Error().Err(err).Str("backup-id", data.Id()).Msg("Failed to find backup")
+		tflog.Error(ctx, "Failed to find backup", map[string]interface{}{"error": err, "backup-id": data.Id()})
 		data.SetId("")
 		return diag.FromErr(err)
 	}
@@ -25,6 +25,7 @@ import (
 	"crypto/sha256"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
@@ -79,14 +80,14 @@ func dataSourceOasisCloudProvider() *schema.Resource {
 // dataSourceOasisCloudProviderRead reloads the resource object from the terraform store.
 func dataSourceOasisCloudProviderRead(ctx context.Context, data *schema.ResourceData, m interface{}) diag.Diagnostics {
 	client := m.(*Client)
-	if err := client.Connect(); err != nil {
-		client.log.Error().Err(err).Msg("Failed to connect to api")
+	if err := client.Connect(ctx); err != nil {
+		tflog.Error(ctx, "Failed to connect to api", map[string]interface{}{"error": err})
 		return diag.FromErr(err)
 	}
 
 	platformc := platform.NewPlatformServiceClient(client.conn)
 	organizationId := data.Get(providerOrganizationFieldName).(string)
-	providersRaw, err := platformc.ListProviders(client.ctxWithToken, &platform.ListProvidersRequest{OrganizationId: organizationId, Options: &common.ListOptions{}})
+	providersRaw, err := platformc.ListProviders(client.apiContext(ctx), &platform.ListProvidersRequest{OrganizationId: organizationId, Options: &common.ListOptions{}})
 	if err != nil {
 		return diag.FromErr(err)
 	}
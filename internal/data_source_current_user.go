@@ -23,6 +23,7 @@ package provider
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
@@ -66,13 +67,13 @@ func dataSourceOasisCurrentUser() *schema.Resource {
 // dataSourceOasisCurrentUserRead reloads the resource object from the terraform store.
 func dataSourceOasisCurrentUserRead(ctx context.Context, data *schema.ResourceData, m interface{}) diag.Diagnostics {
 	client := m.(*Client)
-	if err := client.Connect(); err != nil {
-		client.log.Error().Err(err).Msg("Failed to connect to api")
+	if err := client.Connect(ctx); err != nil {
+		tflog.Error(ctx, "Failed to connect to api", map[string]interface{}{"error": err})
 		return diag.FromErr(err)
 	}
 
 	iamc := iam.NewIAMServiceClient(client.conn)
-	user, err := iamc.GetThisUser(client.ctxWithToken, &common.Empty{})
+	user, err := iamc.GetThisUser(client.apiContext(ctx), &common.Empty{})
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -0,0 +1,181 @@
+//
+// DISCLAIMER
+//
+// Copyright 2024 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	common "github.com/arangodb-managed/apis/common/v1"
+	data "github.com/arangodb-managed/apis/data/v1"
+)
+
+const (
+	// Deployment data source fields, all other fields are those of the deployment resource
+	deplDataSourceIdFieldName = "id"
+)
+
+// deplDataSourceAttributes are the attributes of the deployment resource exposed by the deployment data sources
+var deplDataSourceAttributes = []string{
+	deplProjectFieldName,
+	deplNameFieldName,
+	deplDescriptionFieldName,
+	deplLocationFieldName,
+	deplVersionFieldName,
+	deplSecurityFieldName,
+	deplConfigurationFieldName,
+	deplNotificationConfigurationFieldName,
+	deplDiskPerformanceFieldName,
+	deplDisableScheduledRootPasswordRotationFieldName,
+	deplLastRootPasswordRotatedAtFieldName,
+	deplLockedFieldName,
+	deplDeploymentProfileIDFieldName,
+	deplIsPlatformAuthEnabled,
+	deplDropVSTSupportFieldName,
+	deplIsPausedFieldName,
+	deplLastPausedAtFieldName,
+	deplEndpointFieldName,
+	deplEndpointDefaultFieldName,
+	deplEndpointSelfSignedFieldName,
+	deplPrivateEndpointFieldName,
+	deplPrivateEndpointDefaultFieldName,
+	deplPrivateEndpointSelfSignedFieldName,
+	deplStatusFieldName,
+}
+
+// dataSourceOasisDeployment defines a Deployment datasource terraform type.
+func dataSourceOasisDeployment() *schema.Resource {
+	s := deploymentDataSourceSchema()
+	s[deplDataSourceIdFieldName] = &schema.Schema{
+		Type:         schema.TypeString,
+		Description:  "Deployment Data Source Deployment ID field",
+		Optional:     true,
+		Computed:     true,
+		ExactlyOneOf: []string{deplDataSourceIdFieldName, deplNameFieldName},
+	}
+	s[deplNameFieldName].Optional = true
+	s[deplNameFieldName].Description = "Deployment Data Source Deployment Name field (looked up in the project if no ID is given)"
+	s[deplProjectFieldName].Optional = true
+	s[deplProjectFieldName].Description = "Deployment Data Source Deployment Project field (defaults to the project of the provider)"
+	return &schema.Resource{
+		Description: "Oasis Deployment Data Source",
+		ReadContext: dataSourceOasisDeploymentRead,
+		Schema:      s,
+	}
+}
+
+// deploymentDataSourceSchema returns the schema of the deployment attributes exposed by the data sources,
+// which is the schema of the deployment resource with all attributes computed.
+func deploymentDataSourceSchema() map[string]*schema.Schema {
+	resourceSchema := resourceDeployment().Schema
+	result := make(map[string]*schema.Schema, len(deplDataSourceAttributes))
+	for _, k := range deplDataSourceAttributes {
+		result[k] = computedSchema(resourceSchema[k])
+	}
+	result[deplIsPausedFieldName].Description = "Deployment Data Source Deployment Is Paused field"
+	// The node size selector is only used to select the node size of a new deployment
+	delete(result[deplConfigurationFieldName].Elem.(*schema.Resource).Schema, deplConfigurationNodeSizeSelectorFieldName)
+	return result
+}
+
+// computedSchema returns a copy of the schema of a resource attribute, including all nested attributes,
+// which is computed only and described as a data source attribute.
+func computedSchema(s *schema.Schema) *schema.Schema {
+	result := &schema.Schema{
+		Type:        s.Type,
+		Description: strings.Replace(s.Description, "Resource", "Data Source", 1),
+		Computed:    true,
+		Sensitive:   s.Sensitive,
+	}
+	switch elem := s.Elem.(type) {
+	case *schema.Resource:
+		nested := make(map[string]*schema.Schema, len(elem.Schema))
+		for k, v := range elem.Schema {
+			nested[k] = computedSchema(v)
+		}
+		result.Elem = &schema.Resource{Schema: nested}
+	case *schema.Schema:
+		result.Elem = &schema.Schema{Type: elem.Type}
+	}
+	return result
+}
+
+// dataSourceOasisDeploymentRead reloads the resource object from the Terraform store.
+func dataSourceOasisDeploymentRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+	if err := client.Connect(ctx); err != nil {
+		tflog.Error(ctx, "Failed to connect to api", map[string]interface{}{"error": err})
+		return diag.FromErr(err)
+	}
+
+	var depl *data.Deployment
+	if id, ok := d.GetOk(deplDataSourceIdFieldName); ok {
+		datac := data.NewDataServiceClient(client.conn)
+		var err error
+		if depl, err = datac.GetDeployment(client.apiContext(ctx), &common.IDOptions{Id: id.(string)}); err != nil {
+			tflog.Error(ctx, "Failed to find deployment", map[string]interface{}{"error": err, "deployment-id": id})
+			return diag.FromErr(err)
+		}
+	} else {
+		projectID := client.ProjectID
+		if v, ok := d.GetOk(deplProjectFieldName); ok {
+			projectID = v.(string)
+		}
+		name := d.Get(deplNameFieldName).(string)
+		deployments, err := listDeployments(ctx, client, projectID)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		var found []*data.Deployment
+		for _, item := range deployments {
+			if item.GetName() == name {
+				found = append(found, item)
+			}
+		}
+		if len(found) != 1 {
+			return diag.FromErr(fmt.Errorf("found %d deployments named %q in project %s, expected exactly one", len(found), name, projectID))
+		}
+		depl = found[0]
+	}
+
+	for k, v := range flattenDeploymentDataSource(depl) {
+		if err := d.Set(k, v); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	d.SetId(depl.GetId())
+	return nil
+}
+
+// flattenDeploymentDataSource creates a map from a deployment with all attributes exposed by the data sources.
+func flattenDeploymentDataSource(depl *data.Deployment) map[string]interface{} {
+	result := flattenDeployment(depl)
+	for k, v := range flattenDeploymentEndpoints(depl) {
+		result[k] = v
+	}
+	result[deplStatusFieldName] = flattenDeploymentStatus(depl)
+	return result
+}
@@ -0,0 +1,203 @@
+//
+// DISCLAIMER
+//
+// Copyright 2024 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package provider
+
+import (
+	"context"
+	"math"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	data "github.com/arangodb-managed/apis/data/v1"
+)
+
+const (
+	// Deployment Price data source fields, the location and configuration fields are those of the deployment resource
+	deplPriceDataSourceName                 = "deploymentprice"
+	deplPriceDataSourceSupportPlanFieldName = "support_plan_id"
+	deplPriceDataSourcePerHourFieldName     = "price_per_hour"
+	deplPriceDataSourcePerMonthFieldName    = "price_per_month"
+	deplPriceDataSourceCurrencyFieldName    = "currency_id"
+
+	// deplPriceHoursPerMonth is the average number of hours of a month (365 days * 24 hours / 12 months)
+	deplPriceHoursPerMonth = 730
+)
+
+// dataSourceOasisDeploymentPrice defines a Deployment Price datasource terraform type.
+func dataSourceOasisDeploymentPrice() *schema.Resource {
+	return &schema.Resource{
+		Description: "Oasis Deployment Price Data Source",
+
+		ReadContext: dataSourceOasisDeploymentPriceRead,
+
+		Schema: map[string]*schema.Schema{
+			deplProjectFieldName: {
+				Type:        schema.TypeString,
+				Description: "Deployment Price Data Source Project ID field (defaults to the project of the provider)",
+				Optional:    true,
+				Computed:    true,
+			},
+			deplPriceDataSourceSupportPlanFieldName: {
+				Type:        schema.TypeString,
+				Description: "Deployment Price Data Source Support Plan ID field",
+				Optional:    true,
+			},
+			deplLocationFieldName: {
+				Type:        schema.TypeList,
+				Description: "Deployment Price Data Source Location field",
+				Required:    true,
+				MaxItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						deplLocationRegionFieldName: {
+							Type:        schema.TypeString,
+							Description: "Deployment Price Data Source Location Region field",
+							Required:    true,
+						},
+					},
+				},
+			},
+			deplConfigurationFieldName: {
+				Type:        schema.TypeList,
+				Description: "Deployment Price Data Source Configuration field (defaults as when creating a deployment)",
+				Required:    true,
+				MaxItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						deplConfigurationModelFieldName: {
+							Type:        schema.TypeString,
+							Description: "Deployment Price Data Source Configuration Model field",
+							Required:    true,
+						},
+						deplConfigurationNodeSizeIdFieldName: {
+							Type:        schema.TypeString,
+							Description: "Deployment Price Data Source Configuration Node Size field (defaults to the node size with the least memory)",
+							Optional:    true,
+						},
+						deplConfigurationNodeCountFieldName: {
+							Type:        schema.TypeInt,
+							Description: "Deployment Price Data Source Configuration Node Count field (defaults to 3, always 1 for the developer model)",
+							Optional:    true,
+						},
+						deplConfigurationNodeDiskSizeFieldName: {
+							Type:        schema.TypeInt,
+							Description: "Deployment Price Data Source Configuration Node Disk Size field (in GB, defaults to the minimum disk size of the default node size)",
+							Optional:    true,
+						},
+					},
+				},
+			},
+			deplDiskPerformanceFieldName: {
+				Type:        schema.TypeString,
+				Description: "Deployment Price Data Source Disk Performance field",
+				Optional:    true,
+			},
+			deplPriceDataSourcePerHourFieldName: {
+				Type:        schema.TypeFloat,
+				Description: "Deployment Price Data Source Price Per Hour field",
+				Computed:    true,
+			},
+			deplPriceDataSourcePerMonthFieldName: {
+				Type:        schema.TypeFloat,
+				Description: "Deployment Price Data Source Price Per Month field (price per hour for 730 hours, rounded to cents)",
+				Computed:    true,
+			},
+			deplPriceDataSourceCurrencyFieldName: {
+				Type:        schema.TypeString,
+				Description: "Deployment Price Data Source Currency ID field",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// dataSourceOasisDeploymentPriceRead calculates the price of a deployment with the given configuration.
+func dataSourceOasisDeploymentPriceRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+	if err := client.Connect(ctx); err != nil {
+		tflog.Error(ctx, "Failed to connect to api", map[string]interface{}{"error": err})
+		return diag.FromErr(err)
+	}
+
+	projectID := client.ProjectID
+	if v, ok := d.GetOk(deplProjectFieldName); ok {
+		projectID = v.(string)
+	}
+	loc, err := expandLocation(d.Get(deplLocationFieldName).([]interface{}))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	conf, err := expandConfiguration(d.Get(deplConfigurationFieldName).([]interface{}))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	spec := &data.Deployment_ModelSpec{
+		Model:        conf.model,
+		NodeSizeId:   conf.nodeSizeId,
+		NodeCount:    int32(conf.nodeCount),
+		NodeDiskSize: int32(conf.nodeDiskSize),
+	}
+	if err := defaultDeploymentModel(ctx, client, projectID, loc.region, spec); err != nil {
+		return diag.FromErr(err)
+	}
+
+	req, err := newDeploymentPriceRequest(ctx, client, projectID, loc.region)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	req.SupportPlanId = d.Get(deplPriceDataSourceSupportPlanFieldName).(string)
+	req.Model = spec.GetModel()
+	req.NodeSizeId = spec.GetNodeSizeId()
+	req.NodeCount = spec.GetNodeCount()
+	req.NodeDiskSize = spec.GetNodeDiskSize()
+	req.DiskPerformanceId = d.Get(deplDiskPerformanceFieldName).(string)
+	datac := data.NewDataServiceClient(client.conn)
+	price, err := datac.CalculateDeploymentPrice(client.apiContext(ctx), req)
+	if err != nil {
+		tflog.Error(ctx, "Failed to calculate deployment price", map[string]interface{}{"error": err, "project-id": projectID, "region-id": loc.region, "node-size-id": req.GetNodeSizeId()})
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set(deplProjectFieldName, projectID); err != nil {
+		return diag.FromErr(err)
+	}
+	for k, v := range flattenDeploymentPrice(price) {
+		if err := d.Set(k, v); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	d.SetId(uniqueResourceID(deplPriceDataSourceName))
+	return nil
+}
+
+// flattenDeploymentPrice creates a map from a deployment price for easy storage on terraform.
+// The price per hour is the decimal the API returned, without the rounding errors of its float32 encoding.
+func flattenDeploymentPrice(price *data.DeploymentPrice) map[string]interface{} {
+	perHour, _ := strconv.ParseFloat(strconv.FormatFloat(float64(price.GetPricePerHour()), 'f', -1, 32), 64)
+	return map[string]interface{}{
+		deplPriceDataSourcePerHourFieldName:  perHour,
+		deplPriceDataSourcePerMonthFieldName: math.Round(perHour*deplPriceHoursPerMonth*100) / 100,
+		deplPriceDataSourceCurrencyFieldName: price.GetCurrencyId(),
+	}
+}
@@ -0,0 +1,95 @@
+//
+// DISCLAIMER
+//
+// Copyright 2024 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	data "github.com/arangodb-managed/apis/data/v1"
+)
+
+// TestAccOasisDeploymentPriceDataSourceBasic verifies the price of a deployment configuration is calculated.
+func TestAccOasisDeploymentPriceDataSourceBasic(t *testing.T) {
+	if _, ok := os.LookupEnv("TF_ACC"); !ok {
+		t.Skip()
+	}
+	orgID, err := FetchOrganizationID()
+	require.NoError(t, err)
+	pid, err := FetchProjectID(context.Background(), orgID, testAccProvider)
+	require.NoError(t, err)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOasisDeploymentPriceDataSourceConfigBasic(pid),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.oasis_deployment_price.test_price", "price_per_hour"),
+					resource.TestCheckResourceAttrSet("data.oasis_deployment_price.test_price", "price_per_month"),
+					resource.TestCheckResourceAttrSet("data.oasis_deployment_price.test_price", "currency_id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccOasisDeploymentPriceDataSourceConfigBasic(projectID string) string {
+	return fmt.Sprintf(`
+data "oasis_deployment_price" "test_price" {
+  project = "%s"
+  location {
+    region = "gcp-europe-west4"
+  }
+  configuration {
+    model          = "oneshard"
+    node_size_id   = "c4-a8"
+    node_disk_size = 20
+  }
+}
+`, projectID)
+}
+
+// TestFlattenDeploymentPrice tests the Oasis Deployment Price flattening for Terraform schema compatibility.
+func TestFlattenDeploymentPrice(t *testing.T) {
+	price := &data.DeploymentPrice{
+		PricePerHour: 0.7,
+		CurrencyId:   "usd",
+	}
+	expected := map[string]interface{}{
+		deplPriceDataSourcePerHourFieldName:  0.7,
+		deplPriceDataSourcePerMonthFieldName: 511.0,
+		deplPriceDataSourceCurrencyFieldName: "usd",
+	}
+	assert.Equal(t, expected, flattenDeploymentPrice(price))
+
+	price.PricePerHour = 0.123456
+	flattened := flattenDeploymentPrice(price)
+	assert.Equal(t, 0.123456, flattened[deplPriceDataSourcePerHourFieldName])
+	assert.Equal(t, 90.12, flattened[deplPriceDataSourcePerMonthFieldName])
+}
@@ -0,0 +1,79 @@
+//
+// DISCLAIMER
+//
+// Copyright 2024 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+
+	data "github.com/arangodb-managed/apis/data/v1"
+)
+
+// TestDeploymentDataSourceSchema tests that the deployment data source exposes the attributes of the resource as computed attributes.
+func TestDeploymentDataSourceSchema(t *testing.T) {
+	s := dataSourceOasisDeployment().Schema
+	resourceSchema := resourceDeployment().Schema
+	for _, k := range deplDataSourceAttributes {
+		assert.Equal(t, resourceSchema[k].Type, s[k].Type, k)
+		assert.True(t, s[k].Computed, k)
+	}
+	assert.True(t, s[deplNameFieldName].Optional)
+	assert.True(t, s[deplProjectFieldName].Optional)
+	assert.False(t, s[deplLocationFieldName].Required)
+
+	conf := s[deplConfigurationFieldName].Elem.(*schema.Resource).Schema
+	assert.True(t, conf[deplConfigurationModelFieldName].Computed)
+	assert.False(t, conf[deplConfigurationModelFieldName].Required)
+	assert.NotContains(t, conf, deplConfigurationNodeSizeSelectorFieldName)
+	assert.Contains(t, resourceSchema[deplConfigurationFieldName].Elem.(*schema.Resource).Schema, deplConfigurationNodeSizeSelectorFieldName, "the resource schema is not modified")
+}
+
+// TestFlattenDeploymentDataSource tests that the deployment data source flattens the attributes like the resource.
+func TestFlattenDeploymentDataSource(t *testing.T) {
+	depl := &data.Deployment{
+		Id:        "test-id",
+		Name:      "test-name",
+		ProjectId: "test-project",
+		RegionId:  "gcp-europe-west4",
+		Version:   "3.11.4",
+		Model:     &data.Deployment_ModelSpec{Model: data.ModelOneShard, NodeSizeId: "c4-a8", NodeCount: 3, NodeDiskSize: 32},
+		Status: &data.Deployment_Status{
+			Created:      true,
+			Bootstrapped: true,
+			Ready:        true,
+			IsUpToDate:   true,
+			Endpoint:     "https://test.arangodb.cloud:8529",
+		},
+	}
+	flattened := flattenDeploymentDataSource(depl)
+	for k, v := range flattenDeployment(depl) {
+		assert.Equal(t, v, flattened[k], k)
+	}
+	assert.Equal(t, "https://test.arangodb.cloud:8529", flattened[deplEndpointFieldName])
+	assert.Equal(t, deplPhaseReady, flattened[deplStatusFieldName].([]interface{})[0].(map[string]interface{})[deplStatusPhaseFieldName])
+
+	d := schema.TestResourceDataRaw(t, dataSourceOasisDeployment().Schema, map[string]interface{}{})
+	for k, v := range flattened {
+		assert.NoError(t, d.Set(k, v), k)
+	}
+}
@@ -0,0 +1,191 @@
+//
+// DISCLAIMER
+//
+// Copyright 2024 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package provider
+
+import (
+	"context"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	common "github.com/arangodb-managed/apis/common/v1"
+	data "github.com/arangodb-managed/apis/data/v1"
+)
+
+const (
+	// Deployments data source fields
+	deplsDataSourceName                 = "deployments"
+	deplsDataSourceProjectFieldName     = "project"
+	deplsDataSourceRegionFieldName      = "region"
+	deplsDataSourceModelFieldName       = "model"
+	deplsDataSourceVersionFieldName     = "version"
+	deplsDataSourceNameRegexFieldName   = "name_regex"
+	deplsDataSourceDeploymentsFieldName = "deployments"
+)
+
+// deploymentListPageSize is the number of deployments listed at once
+const deploymentListPageSize = 100
+
+// deploymentFilter is a convenient wrapper around the filters of the deployments data source
+type deploymentFilter struct {
+	region    string
+	model     string
+	version   string
+	nameRegex *regexp.Regexp
+}
+
+// dataSourceOasisDeployments defines a Deployments datasource terraform type.
+func dataSourceOasisDeployments() *schema.Resource {
+	deployment := deploymentDataSourceSchema()
+	deployment[deplDataSourceIdFieldName] = &schema.Schema{
+		Type:        schema.TypeString,
+		Description: "Deployments Data Source Deployment ID field",
+		Computed:    true,
+	}
+	return &schema.Resource{
+		Description: "Oasis Deployments Data Source",
+		ReadContext: dataSourceOasisDeploymentsRead,
+
+		Schema: map[string]*schema.Schema{
+			deplsDataSourceProjectFieldName: {
+				Type:        schema.TypeString,
+				Description: "Deployments Data Source Project ID field (defaults to the project of the provider)",
+				Optional:    true,
+				Computed:    true,
+			},
+			deplsDataSourceRegionFieldName: {
+				Type:        schema.TypeString,
+				Description: "Deployments Data Source Region ID filter field",
+				Optional:    true,
+			},
+			deplsDataSourceModelFieldName: {
+				Type:        schema.TypeString,
+				Description: "Deployments Data Source Model filter field",
+				Optional:    true,
+			},
+			deplsDataSourceVersionFieldName: {
+				Type:        schema.TypeString,
+				Description: "Deployments Data Source ArangoDB Version filter field",
+				Optional:    true,
+			},
+			deplsDataSourceNameRegexFieldName: {
+				Type:         schema.TypeString,
+				Description:  "Deployments Data Source Name Regex filter field (regular expression matching the names of the deployments)",
+				Optional:     true,
+				ValidateFunc: validation.StringIsValidRegExp,
+			},
+			deplsDataSourceDeploymentsFieldName: {
+				Type:        schema.TypeList,
+				Description: "List of the deployments of the project matching all filters",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: deployment,
+				},
+			},
+		},
+	}
+}
+
+// dataSourceOasisDeploymentsRead reloads the resource object from the Terraform store.
+func dataSourceOasisDeploymentsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+	if err := client.Connect(ctx); err != nil {
+		tflog.Error(ctx, "Failed to connect to api", map[string]interface{}{"error": err})
+		return diag.FromErr(err)
+	}
+
+	projectID := client.ProjectID
+	if v, ok := d.GetOk(deplsDataSourceProjectFieldName); ok {
+		projectID = v.(string)
+	}
+	filter := deploymentFilter{
+		region:  d.Get(deplsDataSourceRegionFieldName).(string),
+		model:   d.Get(deplsDataSourceModelFieldName).(string),
+		version: d.Get(deplsDataSourceVersionFieldName).(string),
+	}
+	if v, ok := d.GetOk(deplsDataSourceNameRegexFieldName); ok {
+		filter.nameRegex = regexp.MustCompile(v.(string))
+	}
+	deployments, err := listDeployments(ctx, client, projectID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set(deplsDataSourceProjectFieldName, projectID); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set(deplsDataSourceDeploymentsFieldName, flattenDeployments(filterDeployments(deployments, filter))); err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(uniqueResourceID(deplsDataSourceName))
+	return nil
+}
+
+// listDeployments returns all deployments of a project which are not being deleted.
+func listDeployments(ctx context.Context, client *Client, projectID string) ([]*data.Deployment, error) {
+	datac := data.NewDataServiceClient(client.conn)
+	var result []*data.Deployment
+	for opts := (&common.ListOptions{ContextId: projectID, PageSize: deploymentListPageSize}); ; opts.Page++ {
+		list, err := datac.ListDeployments(client.apiContext(ctx), opts)
+		if err != nil {
+			tflog.Error(ctx, "Failed to list deployments", map[string]interface{}{"error": err, "project-id": projectID})
+			return nil, err
+		}
+		for _, depl := range list.GetItems() {
+			if !depl.GetIsDeleted() {
+				result = append(result, depl)
+			}
+		}
+		if len(list.GetItems()) < int(opts.PageSize) {
+			return result, nil
+		}
+	}
+}
+
+// filterDeployments returns the deployments matching all set filters.
+func filterDeployments(deployments []*data.Deployment, filter deploymentFilter) []*data.Deployment {
+	var result []*data.Deployment
+	for _, depl := range deployments {
+		switch {
+		case filter.region != "" && depl.GetRegionId() != filter.region:
+		case filter.model != "" && depl.GetModel().GetModel() != filter.model:
+		case filter.version != "" && depl.GetVersion() != filter.version:
+		case filter.nameRegex != nil && !filter.nameRegex.MatchString(depl.GetName()):
+		default:
+			result = append(result, depl)
+		}
+	}
+	return result
+}
+
+// flattenDeployments converts the list of deployments into a Terraform consumable format.
+func flattenDeployments(deployments []*data.Deployment) []interface{} {
+	ret := make([]interface{}, 0, len(deployments))
+	for _, depl := range deployments {
+		flattened := flattenDeploymentDataSource(depl)
+		flattened[deplDataSourceIdFieldName] = depl.GetId()
+		ret = append(ret, flattened)
+	}
+	return ret
+}
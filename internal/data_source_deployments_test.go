@@ -0,0 +1,98 @@
+//
+// DISCLAIMER
+//
+// Copyright 2024 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	data "github.com/arangodb-managed/apis/data/v1"
+)
+
+// TestAccOasisDeploymentsDataSourceBasic verifies the deployments of a project are listed.
+func TestAccOasisDeploymentsDataSourceBasic(t *testing.T) {
+	if _, ok := os.LookupEnv("TF_ACC"); !ok {
+		t.Skip()
+	}
+	orgID, err := FetchOrganizationID()
+	require.NoError(t, err)
+	pid, err := FetchProjectID(context.Background(), orgID, testAccProvider)
+	require.NoError(t, err)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+data "oasis_deployments" "test_deployments" {
+  project    = "%s"
+  name_regex = "^terraform-"
+}
+`, pid),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.oasis_deployments.test_deployments", "project", pid),
+					resource.TestCheckResourceAttrSet("data.oasis_deployments.test_deployments", "deployments.#"),
+				),
+			},
+		},
+	})
+}
+
+// TestFilterDeployments tests that only the deployments matching all filters are kept.
+func TestFilterDeployments(t *testing.T) {
+	deployments := []*data.Deployment{
+		{Id: "a", Name: "staging-a", RegionId: "gcp-europe-west4", Version: "3.11.4", Model: &data.Deployment_ModelSpec{Model: data.ModelOneShard}},
+		{Id: "b", Name: "staging-b", RegionId: "aws-us-east-2", Version: "3.11.4", Model: &data.Deployment_ModelSpec{Model: data.ModelSharded}},
+		{Id: "c", Name: "production", RegionId: "gcp-europe-west4", Version: "3.12.0", Model: &data.Deployment_ModelSpec{Model: data.ModelSharded}},
+	}
+	ids := func(deployments []*data.Deployment) []string {
+		result := []string{}
+		for _, depl := range deployments {
+			result = append(result, depl.GetId())
+		}
+		return result
+	}
+
+	assert.Equal(t, []string{"a", "b", "c"}, ids(filterDeployments(deployments, deploymentFilter{})))
+	assert.Equal(t, []string{"a", "c"}, ids(filterDeployments(deployments, deploymentFilter{region: "gcp-europe-west4"})))
+	assert.Equal(t, []string{"b", "c"}, ids(filterDeployments(deployments, deploymentFilter{model: data.ModelSharded})))
+	assert.Equal(t, []string{"a", "b"}, ids(filterDeployments(deployments, deploymentFilter{version: "3.11.4"})))
+	assert.Equal(t, []string{"a", "b"}, ids(filterDeployments(deployments, deploymentFilter{nameRegex: regexp.MustCompile("^staging-")})))
+	assert.Equal(t, []string{"c"}, ids(filterDeployments(deployments, deploymentFilter{region: "gcp-europe-west4", model: data.ModelSharded})))
+	assert.Equal(t, []string{}, ids(filterDeployments(deployments, deploymentFilter{version: "4.0.0"})))
+}
+
+// TestFlattenDeployments tests that every listed deployment is flattened with its ID.
+func TestFlattenDeployments(t *testing.T) {
+	flattened := flattenDeployments([]*data.Deployment{{Id: "a", Name: "staging-a"}})
+	require.Len(t, flattened, 1)
+	assert.Equal(t, "a", flattened[0].(map[string]interface{})[deplDataSourceIdFieldName])
+	assert.Equal(t, "staging-a", flattened[0].(map[string]interface{})[deplNameFieldName])
+	assert.Equal(t, []interface{}{}, flattenDeployments(nil))
+}
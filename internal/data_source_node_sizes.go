@@ -0,0 +1,197 @@
+//
+// DISCLAIMER
+//
+// Copyright 2024 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package provider
+
+import (
+	"context"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	data "github.com/arangodb-managed/apis/data/v1"
+)
+
+const (
+	// Node Sizes data source fields
+	nodeSizesDataSourceName                     = "nodesizes"
+	nodeSizesDataSourceProjectFieldName         = "project"
+	nodeSizesDataSourceRegionFieldName          = "region"
+	nodeSizesDataSourceModelFieldName           = "model"
+	nodeSizesDataSourceDeploymentIdFieldName    = "deployment_id"
+	nodeSizesDataSourceItemsFieldName           = "items"
+	nodeSizesDataSourceIdFieldName              = "id"
+	nodeSizesDataSourceNameFieldName            = "name"
+	nodeSizesDataSourceMemorySizeFieldName      = "memory_size"
+	nodeSizesDataSourceCPUSizeFieldName         = "cpu_size"
+	nodeSizesDataSourceMinDiskSizeFieldName     = "min_disk_size"
+	nodeSizesDataSourceMaxDiskSizeFieldName     = "max_disk_size"
+	nodeSizesDataSourceDefaultDiskSizeFieldName = "default_disk_size"
+	nodeSizesDataSourceDiskSizesFieldName       = "disk_sizes"
+)
+
+// dataSourceOasisNodeSizes defines a Node Sizes datasource terraform type.
+func dataSourceOasisNodeSizes() *schema.Resource {
+	return &schema.Resource{
+		Description: "Oasis Node Sizes Data Source",
+
+		ReadContext: dataSourceOasisNodeSizesRead,
+
+		Schema: map[string]*schema.Schema{
+			nodeSizesDataSourceProjectFieldName: {
+				Type:        schema.TypeString,
+				Description: "Node Sizes Data Source Project ID field (defaults to the project of the provider)",
+				Optional:    true,
+				Computed:    true,
+			},
+			nodeSizesDataSourceRegionFieldName: {
+				Type:        schema.TypeString,
+				Description: "Node Sizes Data Source Region ID field",
+				Required:    true,
+			},
+			nodeSizesDataSourceModelFieldName: {
+				Type:        schema.TypeString,
+				Description: "Node Sizes Data Source Deployment Model field",
+				Required:    true,
+			},
+			nodeSizesDataSourceDeploymentIdFieldName: {
+				Type:        schema.TypeString,
+				Description: "Node Sizes Data Source Deployment ID field (lists the node sizes the deployment can be resized to)",
+				Optional:    true,
+			},
+			nodeSizesDataSourceItemsFieldName: {
+				Type:        schema.TypeList,
+				Description: "List of all node sizes available for a deployment with the given model in the region",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						nodeSizesDataSourceIdFieldName: {
+							Type:        schema.TypeString,
+							Description: "Node Sizes Data Source Node Size ID field",
+							Computed:    true,
+						},
+						nodeSizesDataSourceNameFieldName: {
+							Type:        schema.TypeString,
+							Description: "Node Sizes Data Source Node Size Name field",
+							Computed:    true,
+						},
+						nodeSizesDataSourceMemorySizeFieldName: {
+							Type:        schema.TypeInt,
+							Description: "Node Sizes Data Source Node Size Memory Size field (in GB)",
+							Computed:    true,
+						},
+						nodeSizesDataSourceCPUSizeFieldName: {
+							Type:        schema.TypeString,
+							Description: "Node Sizes Data Source Node Size CPU Size field (e.g. standard or high)",
+							Computed:    true,
+						},
+						nodeSizesDataSourceMinDiskSizeFieldName: {
+							Type:        schema.TypeInt,
+							Description: "Node Sizes Data Source Node Size Min Disk Size field (in GB)",
+							Computed:    true,
+						},
+						nodeSizesDataSourceMaxDiskSizeFieldName: {
+							Type:        schema.TypeInt,
+							Description: "Node Sizes Data Source Node Size Max Disk Size field (in GB)",
+							Computed:    true,
+						},
+						nodeSizesDataSourceDefaultDiskSizeFieldName: {
+							Type:        schema.TypeInt,
+							Description: "Node Sizes Data Source Node Size Default Disk Size field (in GB, used when a deployment is created without disk size)",
+							Computed:    true,
+						},
+						nodeSizesDataSourceDiskSizesFieldName: {
+							Type:        schema.TypeList,
+							Description: "Node Sizes Data Source Node Size Disk Sizes field (in GB, the only disk sizes offered if not empty, otherwise any size between the min and max disk size)",
+							Computed:    true,
+							Elem: &schema.Schema{
+								Type: schema.TypeInt,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// dataSourceOasisNodeSizesRead reloads the resource object from the Terraform store.
+func dataSourceOasisNodeSizesRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+	if err := client.Connect(ctx); err != nil {
+		tflog.Error(ctx, "Failed to connect to api", map[string]interface{}{"error": err})
+		return diag.FromErr(err)
+	}
+
+	projectID := client.ProjectID
+	if v, ok := d.GetOk(nodeSizesDataSourceProjectFieldName); ok {
+		projectID = v.(string)
+	}
+	regionID := d.Get(nodeSizesDataSourceRegionFieldName).(string)
+	datac := data.NewDataServiceClient(client.conn)
+	list, err := datac.ListNodeSizes(client.apiContext(ctx), &data.NodeSizesRequest{
+		ProjectId:    projectID,
+		RegionId:     regionID,
+		DeploymentId: d.Get(nodeSizesDataSourceDeploymentIdFieldName).(string),
+		Model:        d.Get(nodeSizesDataSourceModelFieldName).(string),
+	})
+	if err != nil {
+		tflog.Error(ctx, "Failed to list node sizes", map[string]interface{}{"error": err, "project-id": projectID, "region-id": regionID})
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set(nodeSizesDataSourceProjectFieldName, projectID); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set(nodeSizesDataSourceItemsFieldName, flattenNodeSizes(list.GetItems())); err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(uniqueResourceID(nodeSizesDataSourceName))
+	return nil
+}
+
+// flattenNodeSizes converts the list of node sizes into a Terraform consumable format.
+func flattenNodeSizes(items []*data.NodeSize) []interface{} {
+	ret := make([]interface{}, 0, len(items))
+	for _, v := range items {
+		minDiskSize, maxDiskSize := v.GetMinDiskSize(), v.GetMaxDiskSize()
+		diskSizes := make([]interface{}, 0, len(v.GetDiskSizes()))
+		for _, size := range v.GetDiskSizes() {
+			diskSizes = append(diskSizes, int(size))
+		}
+		// A list of disk sizes overrides the min and max disk size
+		if len(v.GetDiskSizes()) > 0 {
+			minDiskSize, maxDiskSize = slices.Min(v.GetDiskSizes()), slices.Max(v.GetDiskSizes())
+		}
+		ret = append(ret, map[string]interface{}{
+			nodeSizesDataSourceIdFieldName:              v.GetId(),
+			nodeSizesDataSourceNameFieldName:            v.GetName(),
+			nodeSizesDataSourceMemorySizeFieldName:      int(v.GetMemorySize()),
+			nodeSizesDataSourceCPUSizeFieldName:         v.GetCpuSize(),
+			nodeSizesDataSourceMinDiskSizeFieldName:     int(minDiskSize),
+			nodeSizesDataSourceMaxDiskSizeFieldName:     int(maxDiskSize),
+			nodeSizesDataSourceDefaultDiskSizeFieldName: int(minDiskSize),
+			nodeSizesDataSourceDiskSizesFieldName:       diskSizes,
+		})
+	}
+	return ret
+}
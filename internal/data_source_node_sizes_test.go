@@ -0,0 +1,104 @@
+//
+// DISCLAIMER
+//
+// Copyright 2024 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	data "github.com/arangodb-managed/apis/data/v1"
+)
+
+// TestAccOasisNodeSizesDataSourceBasic verifies the node sizes of a model in a region are listed.
+func TestAccOasisNodeSizesDataSourceBasic(t *testing.T) {
+	if _, ok := os.LookupEnv("TF_ACC"); !ok {
+		t.Skip()
+	}
+	orgID, err := FetchOrganizationID()
+	require.NoError(t, err)
+	pid, err := FetchProjectID(context.Background(), orgID, testAccProvider)
+	require.NoError(t, err)
+	rxPosNum := regexp.MustCompile("^[1-9][0-9]*$")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOasisNodeSizesDataSourceConfigBasic(pid),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestMatchResourceAttr("data.oasis_node_sizes.test_node_sizes", "items.#", rxPosNum),
+					resource.TestCheckResourceAttrSet("data.oasis_node_sizes.test_node_sizes", "items.0.id"),
+					resource.TestCheckResourceAttrSet("data.oasis_node_sizes.test_node_sizes", "items.0.memory_size"),
+					resource.TestCheckResourceAttrSet("data.oasis_node_sizes.test_node_sizes", "items.0.default_disk_size"),
+				),
+			},
+		},
+	})
+}
+
+func testAccOasisNodeSizesDataSourceConfigBasic(projectID string) string {
+	return fmt.Sprintf(`
+data "oasis_node_sizes" "test_node_sizes" {
+  project = "%s"
+  region  = "gcp-europe-west4"
+  model   = "oneshard"
+}
+`, projectID)
+}
+
+// TestFlattenNodeSizes tests the Oasis Node Sizes flattening for Terraform schema compatibility.
+func TestFlattenNodeSizes(t *testing.T) {
+	items := []*data.NodeSize{
+		{Id: "c4-a8", Name: "A8", MemorySize: 8, CpuSize: "standard", MinDiskSize: 10, MaxDiskSize: 400},
+		{Id: "fixed", Name: "Fixed", MemorySize: 16, CpuSize: "high", MinDiskSize: 10, MaxDiskSize: 400, DiskSizes: []int32{80, 160}},
+	}
+	expected := []interface{}{
+		map[string]interface{}{
+			nodeSizesDataSourceIdFieldName:              "c4-a8",
+			nodeSizesDataSourceNameFieldName:            "A8",
+			nodeSizesDataSourceMemorySizeFieldName:      8,
+			nodeSizesDataSourceCPUSizeFieldName:         "standard",
+			nodeSizesDataSourceMinDiskSizeFieldName:     10,
+			nodeSizesDataSourceMaxDiskSizeFieldName:     400,
+			nodeSizesDataSourceDefaultDiskSizeFieldName: 10,
+			nodeSizesDataSourceDiskSizesFieldName:       []interface{}{},
+		},
+		map[string]interface{}{
+			nodeSizesDataSourceIdFieldName:              "fixed",
+			nodeSizesDataSourceNameFieldName:            "Fixed",
+			nodeSizesDataSourceMemorySizeFieldName:      16,
+			nodeSizesDataSourceCPUSizeFieldName:         "high",
+			nodeSizesDataSourceMinDiskSizeFieldName:     80,
+			nodeSizesDataSourceMaxDiskSizeFieldName:     160,
+			nodeSizesDataSourceDefaultDiskSizeFieldName: 80,
+			nodeSizesDataSourceDiskSizesFieldName:       []interface{}{80, 160},
+		},
+	}
+	assert.Equal(t, expected, flattenNodeSizes(items))
+}
@@ -23,6 +23,7 @@ package provider
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
@@ -100,8 +101,8 @@ func dataSourceOasisNotebookModel() *schema.Resource {
 // dataSourceOasisNotebookModelRead reloads the resource object from the Terraform store.
 func dataSourceOasisNotebookModelRead(ctx context.Context, data *schema.ResourceData, m interface{}) diag.Diagnostics {
 	client := m.(*Client)
-	if err := client.Connect(); err != nil {
-		client.log.Error().Err(err).Msg("Failed to connect to api")
+	if err := client.Connect(ctx); err != nil {
+		tflog.Error(ctx, "Failed to connect to api", map[string]interface{}{"error": err})
 		return diag.FromErr(err)
 	}
 
@@ -114,11 +115,11 @@ func dataSourceOasisNotebookModelRead(ctx context.Context, data *schema.Resource
 		return diag.Errorf("deployment id required")
 	}
 
-	response, err := nbc.ListNotebookModels(client.ctxWithToken, &nb.ListNotebookModelsRequest{
+	response, err := nbc.ListNotebookModels(client.apiContext(ctx), &nb.ListNotebookModelsRequest{
 		DeploymentId: deploymentId,
 	})
 	if err != nil {
-		client.log.Error().Err(err).Msg("Failed to get list of notebook models.")
+		tflog.Error(ctx, "Failed to get list of notebook models.", map[string]interface{}{"error": err})
 		return diag.FromErr(err)
 	}
 
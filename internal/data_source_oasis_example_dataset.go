@@ -24,6 +24,7 @@ import (
 	"context"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
@@ -94,8 +95,8 @@ func dataSourceOasisExampleDataset() *schema.Resource {
 // dataSourceOasisExampleDatasetRead reloads the resource object from the terraform store.
 func dataSourceOasisExampleDatasetRead(ctx context.Context, data *schema.ResourceData, m interface{}) diag.Diagnostics {
 	client := m.(*Client)
-	if err := client.Connect(); err != nil {
-		client.log.Error().Err(err).Msg("Failed to connect to api")
+	if err := client.Connect(ctx); err != nil {
+		tflog.Error(ctx, "Failed to connect to api", map[string]interface{}{"error": err})
 		return diag.FromErr(err)
 	}
 
@@ -104,11 +105,11 @@ func dataSourceOasisExampleDatasetRead(ctx context.Context, data *schema.Resourc
 	if v, ok := data.GetOk(exampleOrganizationIDFieldName); ok {
 		orgID = v.(string)
 	}
-	response, err := examplec.ListExampleDatasets(client.ctxWithToken, &example.ListExampleDatasetsRequest{
+	response, err := examplec.ListExampleDatasets(client.apiContext(ctx), &example.ListExampleDatasetsRequest{
 		OrganizationId: orgID,
 	})
 	if err != nil {
-		client.log.Error().Err(err).Msg("Failed to get list of example datasets.")
+		tflog.Error(ctx, "Failed to get list of example datasets.", map[string]interface{}{"error": err})
 		return diag.FromErr(err)
 	}
 
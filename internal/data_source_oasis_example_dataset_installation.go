@@ -24,6 +24,7 @@ import (
 	"context"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
@@ -109,18 +110,18 @@ func dataSourceOasisExampleDatasetInstallation() *schema.Resource {
 // dataSourceOasisExampleDatasetInstallationRead reloads the resource object from the terraform store.
 func dataSourceOasisExampleDatasetInstallationRead(ctx context.Context, data *schema.ResourceData, m interface{}) diag.Diagnostics {
 	client := m.(*Client)
-	if err := client.Connect(); err != nil {
-		client.log.Error().Err(err).Msg("Failed to connect to api")
+	if err := client.Connect(ctx); err != nil {
+		tflog.Error(ctx, "Failed to connect to api", map[string]interface{}{"error": err})
 		return diag.FromErr(err)
 	}
 
 	examplec := example.NewExampleDatasetServiceClient(client.conn)
 	deplID := data.Get(installationDeploymentIdFieldName).(string)
-	response, err := examplec.ListExampleDatasetInstallations(client.ctxWithToken, &example.ListExampleDatasetInstallationsRequest{
+	response, err := examplec.ListExampleDatasetInstallations(client.apiContext(ctx), &example.ListExampleDatasetInstallationsRequest{
 		DeploymentId: deplID,
 	})
 	if err != nil {
-		client.log.Error().Str("deployment-id", deplID).Err(err).Msg("Failed to get list of example installations for deployment.")
+		tflog.Error(ctx, "Failed to get list of example installations for deployment.", map[string]interface{}{"deployment-id": deplID, "error": err})
 		return diag.FromErr(err)
 	}
 
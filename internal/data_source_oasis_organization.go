@@ -24,6 +24,7 @@ import (
 	"context"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
@@ -126,14 +127,14 @@ func dataSourceOasisOrganization() *schema.Resource {
 // dataSourceOasisOrganizationRead reloads the resource object from the terraform store.
 func dataSourceOasisOrganizationRead(ctx context.Context, data *schema.ResourceData, m interface{}) diag.Diagnostics {
 	client := m.(*Client)
-	if err := client.Connect(); err != nil {
-		client.log.Error().Err(err).Msg("Failed to connect to api")
+	if err := client.Connect(ctx); err != nil {
+		tflog.Error(ctx, "Failed to connect to api", map[string]interface{}{"error": err})
 		return diag.FromErr(err)
 	}
 
 	rmc := rm.NewResourceManagerServiceClient(client.conn)
 	oid := data.Get(orgIdFieldName).(string)
-	org, err := rmc.GetOrganization(client.ctxWithToken, &common.IDOptions{Id: oid})
+	org, err := rmc.GetOrganization(client.apiContext(ctx), &common.IDOptions{Id: oid})
 	if err != nil {
 		return diag.FromErr(err)
 	}
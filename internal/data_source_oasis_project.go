@@ -24,6 +24,7 @@ import (
 	"context"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
@@ -79,14 +80,14 @@ func dataSourceOasisProject() *schema.Resource {
 // dataSourceOasisProjectRead reloads the resource object from the terraform store.
 func dataSourceOasisProjectRead(ctx context.Context, data *schema.ResourceData, m interface{}) diag.Diagnostics {
 	client := m.(*Client)
-	if err := client.Connect(); err != nil {
-		client.log.Error().Err(err).Msg("Failed to connect to api")
+	if err := client.Connect(ctx); err != nil {
+		tflog.Error(ctx, "Failed to connect to api", map[string]interface{}{"error": err})
 		return diag.FromErr(err)
 	}
 
 	rmc := rm.NewResourceManagerServiceClient(client.conn)
 	pid := data.Get(projIdFieldName).(string)
-	proj, err := rmc.GetProject(client.ctxWithToken, &common.IDOptions{Id: pid})
+	proj, err := rmc.GetProject(client.apiContext(ctx), &common.IDOptions{Id: pid})
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -24,6 +24,7 @@ import (
 	"context"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
@@ -73,8 +74,8 @@ func dataSourceTermsAndConditions() *schema.Resource {
 // dataSourceTermsAndConditionsRead reloads the resource object from the terraform store.
 func dataSourceTermsAndConditionsRead(ctx context.Context, data *schema.ResourceData, m interface{}) diag.Diagnostics {
 	client := m.(*Client)
-	if err := client.Connect(); err != nil {
-		client.log.Error().Err(err).Msg("Failed to connect to api")
+	if err := client.Connect(ctx); err != nil {
+		tflog.Error(ctx, "Failed to connect to api", map[string]interface{}{"error": err})
 		return diag.FromErr(err)
 	}
 
@@ -84,7 +85,7 @@ func dataSourceTermsAndConditionsRead(ctx context.Context, data *schema.Resource
 		err error
 	)
 	if v, ok := data.GetOk(tcIDFieldName); ok {
-		tc, err = rmc.GetTermsAndConditions(client.ctxWithToken, &common.IDOptions{Id: v.(string)})
+		tc, err = rmc.GetTermsAndConditions(client.apiContext(ctx), &common.IDOptions{Id: v.(string)})
 		if err != nil {
 			return diag.FromErr(err)
 		}
@@ -93,7 +94,7 @@ func dataSourceTermsAndConditionsRead(ctx context.Context, data *schema.Resource
 		if v, ok := data.GetOk(tcOrganizationFieldName); ok {
 			orgID = v.(string)
 		}
-		tc, err = rmc.GetCurrentTermsAndConditions(client.ctxWithToken, &common.IDOptions{Id: orgID})
+		tc, err = rmc.GetCurrentTermsAndConditions(client.apiContext(ctx), &common.IDOptions{Id: orgID})
 		if err != nil {
 			return diag.FromErr(err)
 		}
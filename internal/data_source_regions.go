@@ -25,6 +25,7 @@ import (
 	"crypto/sha256"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
@@ -95,15 +96,15 @@ func dataSourceOasisRegion() *schema.Resource {
 // dataSourceOasisRegionRead reloads the resource object from the Terraform store.
 func dataSourceOasisRegionRead(ctx context.Context, data *schema.ResourceData, m interface{}) diag.Diagnostics {
 	client := m.(*Client)
-	if err := client.Connect(); err != nil {
-		client.log.Error().Err(err).Msg("Failed to connect to api")
+	if err := client.Connect(ctx); err != nil {
+		tflog.Error(ctx, "Failed to connect to api", map[string]interface{}{"error": err})
 		return diag.FromErr(err)
 	}
 
 	platformc := platform.NewPlatformServiceClient(client.conn)
 	organizationId := data.Get(regionOrganizationFieldName).(string)
 	providerId := data.Get(regionProviderIdFieldName).(string)
-	regionsRaw, err := platformc.ListRegions(client.ctxWithToken, &platform.ListRegionsRequest{OrganizationId: organizationId, ProviderId: providerId, Options: &common.ListOptions{}})
+	regionsRaw, err := platformc.ListRegions(client.apiContext(ctx), &platform.ListRegionsRequest{OrganizationId: organizationId, ProviderId: providerId, Options: &common.ListOptions{}})
 	if err != nil {
 		return diag.FromErr(err)
 	}
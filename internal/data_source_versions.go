@@ -0,0 +1,197 @@
+//
+// DISCLAIMER
+//
+// Copyright 2024 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package provider
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	common "github.com/arangodb-managed/apis/common/v1"
+	data "github.com/arangodb-managed/apis/data/v1"
+)
+
+const (
+	// Versions data source fields
+	versionsDataSourceName                              = "versions"
+	versionsDataSourceOrganizationFieldName             = "organization"
+	versionsDataSourceCurrentVersionFieldName           = "current_version"
+	versionsDataSourceDefaultVersionFieldName           = "default_version"
+	versionsDataSourceItemsFieldName                    = "items"
+	versionsDataSourceVersionFieldName                  = "version"
+	versionsDataSourceIsDefaultFieldName                = "is_default"
+	versionsDataSourceIsEndOfLifeFieldName              = "is_end_of_life"
+	versionsDataSourceReleaseNotesURLFieldName          = "release_notes_url"
+	versionsDataSourceReplacedByFieldName               = "replaced_by"
+	versionsDataSourceReplaceReasonFieldName            = "replace_reason"
+	versionsDataSourceAutoUpdateDateFieldName           = "auto_update_date"
+	versionsDataSourceRecommendedUpgradeFieldName       = "recommended_upgrade"
+	versionsDataSourceRecommendedUpgradeReasonFieldName = "recommended_upgrade_reason"
+)
+
+// dataSourceOasisVersions defines a Versions datasource terraform type.
+func dataSourceOasisVersions() *schema.Resource {
+	return &schema.Resource{
+		Description: "Oasis ArangoDB Versions Data Source",
+
+		ReadContext: dataSourceOasisVersionsRead,
+
+		Schema: map[string]*schema.Schema{
+			versionsDataSourceOrganizationFieldName: {
+				Type:        schema.TypeString,
+				Description: "Versions Data Source Organization ID field (includes the versions only available to this organization, defaults to the organization of the provider)",
+				Optional:    true,
+				Computed:    true,
+			},
+			versionsDataSourceCurrentVersionFieldName: {
+				Type:        schema.TypeString,
+				Description: "Versions Data Source Current Version field (if set, only the versions which are a supported upgrade from this version are listed)",
+				Optional:    true,
+			},
+			versionsDataSourceDefaultVersionFieldName: {
+				Type:        schema.TypeString,
+				Description: "Versions Data Source Default Version field (version of new deployments without version)",
+				Computed:    true,
+			},
+			versionsDataSourceItemsFieldName: {
+				Type:        schema.TypeList,
+				Description: "List of all available ArangoDB versions",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						versionsDataSourceVersionFieldName: {
+							Type:        schema.TypeString,
+							Description: "Versions Data Source Version field (major.minor.patch)",
+							Computed:    true,
+						},
+						versionsDataSourceIsDefaultFieldName: {
+							Type:        schema.TypeBool,
+							Description: "Versions Data Source Is Default field",
+							Computed:    true,
+						},
+						versionsDataSourceIsEndOfLifeFieldName: {
+							Type:        schema.TypeBool,
+							Description: "Versions Data Source Is End Of Life field (the version is no longer actively supported)",
+							Computed:    true,
+						},
+						versionsDataSourceReleaseNotesURLFieldName: {
+							Type:        schema.TypeString,
+							Description: "Versions Data Source Release Notes URL field",
+							Computed:    true,
+						},
+						versionsDataSourceReplacedByFieldName: {
+							Type:        schema.TypeString,
+							Description: "Versions Data Source Replaced By field (version deployments using this version will be upgraded to)",
+							Computed:    true,
+						},
+						versionsDataSourceReplaceReasonFieldName: {
+							Type:        schema.TypeString,
+							Description: "Versions Data Source Replace Reason field",
+							Computed:    true,
+						},
+						versionsDataSourceAutoUpdateDateFieldName: {
+							Type:        schema.TypeString,
+							Description: "Versions Data Source Auto Update Date field (date at which deployments using this version are upgraded automatically, empty if they are not)",
+							Computed:    true,
+						},
+						versionsDataSourceRecommendedUpgradeFieldName: {
+							Type:        schema.TypeString,
+							Description: "Versions Data Source Recommended Upgrade field (version deployments using this version are recommended to be upgraded to)",
+							Computed:    true,
+						},
+						versionsDataSourceRecommendedUpgradeReasonFieldName: {
+							Type:        schema.TypeString,
+							Description: "Versions Data Source Recommended Upgrade Reason field",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// dataSourceOasisVersionsRead reloads the resource object from the Terraform store.
+func dataSourceOasisVersionsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+	if err := client.Connect(ctx); err != nil {
+		tflog.Error(ctx, "Failed to connect to api", map[string]interface{}{"error": err})
+		return diag.FromErr(err)
+	}
+
+	orgID := client.OrganizationID
+	if v, ok := d.GetOk(versionsDataSourceOrganizationFieldName); ok {
+		orgID = v.(string)
+	}
+	datac := data.NewDataServiceClient(client.conn)
+	defaultVersion, err := datac.GetDefaultVersion(client.apiContext(ctx), &common.Empty{})
+	if err != nil {
+		tflog.Error(ctx, "Failed to get default version", map[string]interface{}{"error": err})
+		return diag.FromErr(err)
+	}
+	versions, err := listVersions(ctx, client, orgID, d.Get(versionsDataSourceCurrentVersionFieldName).(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	for k, v := range flattenVersions(orgID, defaultVersion.GetVersion(), versions) {
+		if err := d.Set(k, v); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	d.SetId(uniqueResourceID(versionsDataSourceName))
+	return nil
+}
+
+// flattenVersions takes the default and available versions and converts them into a Terraform consumable format.
+func flattenVersions(orgID, defaultVersion string, items []*data.Version) map[string]interface{} {
+	return map[string]interface{}{
+		versionsDataSourceOrganizationFieldName:   orgID,
+		versionsDataSourceDefaultVersionFieldName: defaultVersion,
+		versionsDataSourceItemsFieldName:          flattenVersionList(defaultVersion, items),
+	}
+}
+
+// flattenVersionList converts the list of versions into a Terraform consumable format.
+func flattenVersionList(defaultVersion string, items []*data.Version) []interface{} {
+	ret := make([]interface{}, 0, len(items))
+	for _, v := range items {
+		autoUpdateDate := ""
+		if date := v.GetReplaceBy().GetAutoUpdateDate(); date != nil {
+			autoUpdateDate = date.AsTime().Format(time.RFC3339Nano)
+		}
+		ret = append(ret, map[string]interface{}{
+			versionsDataSourceVersionFieldName:                  v.GetVersion(),
+			versionsDataSourceIsDefaultFieldName:                v.GetVersion() == defaultVersion,
+			versionsDataSourceIsEndOfLifeFieldName:              v.GetIsEndOfLife(),
+			versionsDataSourceReleaseNotesURLFieldName:          v.GetReleaseNotesUrl(),
+			versionsDataSourceReplacedByFieldName:               v.GetReplaceBy().GetVersion(),
+			versionsDataSourceReplaceReasonFieldName:            v.GetReplaceBy().GetReason(),
+			versionsDataSourceAutoUpdateDateFieldName:           autoUpdateDate,
+			versionsDataSourceRecommendedUpgradeFieldName:       v.GetUpgradeRecommendation().GetVersion(),
+			versionsDataSourceRecommendedUpgradeReasonFieldName: v.GetUpgradeRecommendation().GetReason(),
+		})
+	}
+	return ret
+}
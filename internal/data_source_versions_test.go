@@ -0,0 +1,107 @@
+//
+// DISCLAIMER
+//
+// Copyright 2024 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package provider
+
+import (
+	"os"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	data "github.com/arangodb-managed/apis/data/v1"
+)
+
+// TestAccOasisVersionsDataSourceBasic verifies the available ArangoDB versions and the default version are listed.
+func TestAccOasisVersionsDataSourceBasic(t *testing.T) {
+	if _, ok := os.LookupEnv("TF_ACC"); !ok {
+		t.Skip()
+	}
+	rxPosNum := regexp.MustCompile("^[1-9][0-9]*$")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `data "oasis_versions" "test_versions" {}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestMatchResourceAttr("data.oasis_versions.test_versions", "items.#", rxPosNum),
+					resource.TestCheckResourceAttrSet("data.oasis_versions.test_versions", "items.0.version"),
+					resource.TestCheckResourceAttrSet("data.oasis_versions.test_versions", "default_version"),
+				),
+			},
+		},
+	})
+}
+
+// TestFlattenVersions tests the Oasis Versions flattening for Terraform schema compatibility.
+func TestFlattenVersions(t *testing.T) {
+	items := []*data.Version{
+		{
+			Version:         "3.10.14",
+			IsEndOfLife:     true,
+			ReleaseNotesUrl: "https://docs.arangodb.com/3.10/release-notes/",
+			ReplaceBy: &data.ReplaceVersionBy{
+				Version:        "3.11.8",
+				Reason:         "End of life",
+				AutoUpdateDate: timestamppb.New(time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)),
+			},
+			UpgradeRecommendation: &data.UpgradeVersionRecommendation{Version: "3.11.8", Reason: "Security fixes"},
+		},
+		{
+			Version:         "3.11.8",
+			ReleaseNotesUrl: "https://docs.arangodb.com/3.11/release-notes/",
+		},
+	}
+	expected := map[string]interface{}{
+		versionsDataSourceOrganizationFieldName:   "test-org-id",
+		versionsDataSourceDefaultVersionFieldName: "3.11.8",
+		versionsDataSourceItemsFieldName: []interface{}{
+			map[string]interface{}{
+				versionsDataSourceVersionFieldName:                  "3.10.14",
+				versionsDataSourceIsDefaultFieldName:                false,
+				versionsDataSourceIsEndOfLifeFieldName:              true,
+				versionsDataSourceReleaseNotesURLFieldName:          "https://docs.arangodb.com/3.10/release-notes/",
+				versionsDataSourceReplacedByFieldName:               "3.11.8",
+				versionsDataSourceReplaceReasonFieldName:            "End of life",
+				versionsDataSourceAutoUpdateDateFieldName:           "2024-06-01T00:00:00Z",
+				versionsDataSourceRecommendedUpgradeFieldName:       "3.11.8",
+				versionsDataSourceRecommendedUpgradeReasonFieldName: "Security fixes",
+			},
+			map[string]interface{}{
+				versionsDataSourceVersionFieldName:                  "3.11.8",
+				versionsDataSourceIsDefaultFieldName:                true,
+				versionsDataSourceIsEndOfLifeFieldName:              false,
+				versionsDataSourceReleaseNotesURLFieldName:          "https://docs.arangodb.com/3.11/release-notes/",
+				versionsDataSourceReplacedByFieldName:               "",
+				versionsDataSourceReplaceReasonFieldName:            "",
+				versionsDataSourceAutoUpdateDateFieldName:           "",
+				versionsDataSourceRecommendedUpgradeFieldName:       "",
+				versionsDataSourceRecommendedUpgradeReasonFieldName: "",
+			},
+		},
+	}
+	assert.Equal(t, expected, flattenVersions("test-org-id", "3.11.8", items))
+}
@@ -0,0 +1,40 @@
+//
+// DISCLAIMER
+//
+// Copyright 2024 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package provider
+
+import (
+	common "github.com/arangodb-managed/apis/common/v1"
+)
+
+// softDeletable is implemented by all Oasis objects which are marked as deleted before they are removed
+type softDeletable interface {
+	GetIsDeleted() bool
+}
+
+// isRemoved returns true if the result of a Get call shows that the object has been deleted
+// outside of Terraform, because it is either not found or marked as deleted.
+// Read functions remove such resources from the state, so that they are planned to be created again.
+func isRemoved(obj softDeletable, err error) bool {
+	if err != nil {
+		return common.IsNotFound(err)
+	}
+	return obj.GetIsDeleted()
+}
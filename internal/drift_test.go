@@ -0,0 +1,42 @@
+//
+// DISCLAIMER
+//
+// Copyright 2024 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package provider
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	data "github.com/arangodb-managed/apis/data/v1"
+)
+
+// TestIsRemoved tests that only objects which are not found or marked as deleted are removed from the state.
+func TestIsRemoved(t *testing.T) {
+	assert.True(t, isRemoved((*data.Deployment)(nil), status.Error(codes.NotFound, "not found")))
+	assert.True(t, isRemoved(&data.Deployment{IsDeleted: true}, nil))
+	assert.False(t, isRemoved(&data.Deployment{}, nil))
+	assert.False(t, isRemoved((*data.Deployment)(nil), status.Error(codes.Unavailable, "unavailable")))
+	assert.False(t, isRemoved((*data.Deployment)(nil), status.Error(codes.PermissionDenied, "denied")))
+	assert.False(t, isRemoved((*data.Deployment)(nil), errors.New("connection reset")))
+}
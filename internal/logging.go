@@ -0,0 +1,199 @@
+//
+// DISCLAIMER
+//
+// Copyright 2024 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package provider
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+const (
+	// apiLogSubsystem is the logging subsystem of the calls to the Arango Graph API
+	apiLogSubsystem = "api"
+	// apiLogLevelEnv sets the log level of apiLogSubsystem independently of TF_LOG_PROVIDER
+	apiLogLevelEnv = "TF_LOG_PROVIDER_OASIS_API"
+	// redactedValue replaces secret values in logged payloads and errors
+	redactedValue = "***"
+)
+
+// sensitiveFieldNames are the parts of field names whose values are never logged
+var sensitiveFieldNames = []string{"secret", "token", "password", "private_key"}
+
+// traceInterceptor logs every call to the Arango Graph API.
+// The method, duration, status code and resource ID are logged at DEBUG level,
+// the request and response payloads at TRACE level with all secrets redacted.
+func (c *Client) traceInterceptor(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+	start := time.Now()
+	err := invoker(ctx, method, req, reply, cc, opts...)
+	fields := map[string]interface{}{
+		"method":   method,
+		"duration": time.Since(start).String(),
+		"code":     status.Code(err).String(),
+	}
+	if id := requestResourceID(req); id != "" {
+		fields["resource_id"] = id
+	}
+	if err != nil {
+		fields["error"] = c.redact(err.Error())
+	}
+	tflog.SubsystemDebug(ctx, apiLogSubsystem, "Called Arango Graph API", fields)
+
+	payloads := map[string]interface{}{
+		"method":  method,
+		"request": redactedPayload(req),
+	}
+	if err == nil {
+		payloads["response"] = redactedPayload(reply)
+	}
+	tflog.SubsystemTrace(ctx, apiLogSubsystem, "Arango Graph API payloads", payloads)
+	return err
+}
+
+// registerSecret adds a value which must be redacted from all logged errors.
+func (c *Client) registerSecret(secret string) {
+	if secret == "" {
+		return
+	}
+	c.secretsMutex.Lock()
+	defer c.secretsMutex.Unlock()
+	c.secrets = append(c.secrets, secret)
+}
+
+// redact replaces all known secret values in the given string.
+func (c *Client) redact(s string) string {
+	c.secretsMutex.Lock()
+	defer c.secretsMutex.Unlock()
+	for _, secret := range append([]string{c.ApiKeySecret, c.AccessToken}, c.secrets...) {
+		if secret != "" {
+			s = strings.ReplaceAll(s, secret, redactedValue)
+		}
+	}
+	return s
+}
+
+// requestResourceID returns the ID of the resource a request refers to, if any.
+func requestResourceID(req interface{}) string {
+	if r, ok := req.(interface{ GetId() string }); ok && r.GetId() != "" {
+		return r.GetId()
+	}
+	if r, ok := req.(interface{ GetDeploymentId() string }); ok && r.GetDeploymentId() != "" {
+		return r.GetDeploymentId()
+	}
+	if r, ok := req.(interface{ GetUrl() string }); ok && r.GetUrl() != "" {
+		return r.GetUrl()
+	}
+	if r, ok := req.(interface{ GetContextId() string }); ok && r.GetContextId() != "" {
+		return r.GetContextId()
+	}
+	if r, ok := req.(interface{ GetProjectId() string }); ok && r.GetProjectId() != "" {
+		return r.GetProjectId()
+	}
+	if r, ok := req.(interface{ GetOrganizationId() string }); ok && r.GetOrganizationId() != "" {
+		return r.GetOrganizationId()
+	}
+	return ""
+}
+
+// redactedPayload returns the JSON encoding of a request or response with
+// the values of all sensitive fields replaced.
+func redactedPayload(payload interface{}) string {
+	msg, ok := payload.(proto.Message)
+	if !ok {
+		return ""
+	}
+	clone := proto.Clone(msg)
+	redactMessage(clone.ProtoReflect())
+	encoded, err := protojson.Marshal(clone)
+	if err != nil {
+		return ""
+	}
+	return string(encoded)
+}
+
+// redactMessage replaces the values of all sensitive string and bytes fields
+// of the given message and its nested messages.
+func redactMessage(m protoreflect.Message) {
+	var sensitive []protoreflect.FieldDescriptor
+	m.Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		switch {
+		case fd.IsList():
+			list := v.List()
+			for i := 0; i < list.Len(); i++ {
+				if isMessageField(fd) {
+					redactMessage(list.Get(i).Message())
+				} else if isSensitiveField(fd) {
+					list.Set(i, redactedScalar(fd))
+				}
+			}
+		case fd.IsMap():
+			if isMessageField(fd.MapValue()) {
+				v.Map().Range(func(_ protoreflect.MapKey, mv protoreflect.Value) bool {
+					redactMessage(mv.Message())
+					return true
+				})
+			}
+		case isMessageField(fd):
+			redactMessage(v.Message())
+		case isSensitiveField(fd):
+			sensitive = append(sensitive, fd)
+		}
+		return true
+	})
+	// Fields are set after ranging, since mutating a message while ranging over it is undefined
+	for _, fd := range sensitive {
+		m.Set(fd, redactedScalar(fd))
+	}
+}
+
+// isMessageField returns true if the field holds (a list of) messages.
+func isMessageField(fd protoreflect.FieldDescriptor) bool {
+	return fd.Kind() == protoreflect.MessageKind || fd.Kind() == protoreflect.GroupKind
+}
+
+// isSensitiveField returns true if the field holds a secret value.
+func isSensitiveField(fd protoreflect.FieldDescriptor) bool {
+	if fd.Kind() != protoreflect.StringKind && fd.Kind() != protoreflect.BytesKind {
+		return false
+	}
+	name := strings.ToLower(string(fd.Name()))
+	for _, s := range sensitiveFieldNames {
+		if strings.Contains(name, s) {
+			return true
+		}
+	}
+	return false
+}
+
+// redactedScalar returns the value replacing a sensitive field.
+func redactedScalar(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	if fd.Kind() == protoreflect.BytesKind {
+		return protoreflect.ValueOfBytes([]byte(redactedValue))
+	}
+	return protoreflect.ValueOfString(redactedValue)
+}
@@ -0,0 +1,61 @@
+//
+// DISCLAIMER
+//
+// Copyright 2024 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package provider
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	common "github.com/arangodb-managed/apis/common/v1"
+	data "github.com/arangodb-managed/apis/data/v1"
+	iam "github.com/arangodb-managed/apis/iam/v1"
+)
+
+func TestRedactedPayload(t *testing.T) {
+	req := &iam.AuthenticateAPIKeyRequest{Id: "key-id", Secret: "key-secret"}
+	payload := redactedPayload(req)
+	assert.Contains(t, payload, "key-id")
+	assert.NotContains(t, payload, "key-secret")
+	assert.Equal(t, "key-secret", req.GetSecret(), "original request must not be modified")
+
+	resp := &data.DeploymentCredentials{Username: "root", Password: "root-password"}
+	payload = redactedPayload(resp)
+	assert.Contains(t, payload, "root")
+	assert.NotContains(t, payload, "root-password")
+
+	assert.Empty(t, redactedPayload(errors.New("not a message")))
+}
+
+func TestRequestResourceID(t *testing.T) {
+	assert.Equal(t, "depl-id", requestResourceID(&common.IDOptions{Id: "depl-id"}))
+	assert.Equal(t, "proj-id", requestResourceID(&common.ListOptions{ContextId: "proj-id"}))
+	assert.Equal(t, "depl-id", requestResourceID(&data.Deployment{Id: "depl-id", ProjectId: "proj-id"}))
+	assert.Empty(t, requestResourceID(&common.Empty{}))
+}
+
+func TestRedact(t *testing.T) {
+	client := &Client{ApiKeySecret: "key-secret"}
+	client.registerSecret("auth-token")
+	client.registerSecret("")
+	assert.Equal(t, "invalid *** and ***", client.redact("invalid key-secret and auth-token"))
+}
@@ -25,6 +25,7 @@ import (
 	"fmt"
 	"os"
 
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 
@@ -48,16 +49,16 @@ func FetchProjectID(ctx context.Context, orgID string, testAccProvider *schema.P
 		return "", fmt.Errorf("terraform config error")
 	}
 	client := testAccProvider.Meta().(*Client)
-	if err := client.Connect(); err != nil {
-		client.log.Error().Err(err).Msg("Failed to connect to api")
+	if err := client.Connect(ctx); err != nil {
+		tflog.Error(ctx, "Failed to connect to api", map[string]interface{}{"error": err})
 		return "", err
 	}
 	rmc := rm.NewResourceManagerServiceClient(client.conn)
-	if projects, err := rmc.ListProjects(client.ctxWithToken, &common.ListOptions{ContextId: orgID}); err != nil {
-		client.log.Error().Err(err).Str("organization-id", orgID).Msg("Failed to list projects for organization")
+	if projects, err := rmc.ListProjects(client.apiContext(ctx), &common.ListOptions{ContextId: orgID}); err != nil {
+		tflog.Error(ctx, "Failed to list projects for organization", map[string]interface{}{"error": err, "organization-id": orgID})
 		return "", err
 	} else if len(projects.GetItems()) < 1 {
-		client.log.Error().Err(err).Str("organization-id", orgID).Msg("No projects found")
+		tflog.Error(ctx, "No projects found", map[string]interface{}{"error": err, "organization-id": orgID})
 		return "", fmt.Errorf("no projects found")
 	} else {
 		return projects.GetItems()[0].GetId(), nil
@@ -24,6 +24,9 @@ import (
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 var (
@@ -49,3 +52,11 @@ func TestProvider(t *testing.T) {
 		t.Fatalf("err: %s", err)
 	}
 }
+
+// TestConnectErrorAttribute tests that connection errors are reported against the right provider argument.
+func TestConnectErrorAttribute(t *testing.T) {
+	client := &Client{credentialsAttribute: "api_key_id"}
+	assert.Equal(t, "api_key_id", connectErrorAttribute(client, status.Error(codes.Unauthenticated, "invalid key")))
+	assert.Equal(t, "api_key_id", connectErrorAttribute(client, status.Error(codes.PermissionDenied, "denied")))
+	assert.Equal(t, "oasis_endpoint", connectErrorAttribute(client, status.Error(codes.Unavailable, "no route")))
+}
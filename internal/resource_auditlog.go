@@ -25,6 +25,7 @@ import (
 	"errors"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
@@ -48,6 +49,15 @@ func resourceAuditLog() *schema.Resource {
 		ReadContext:   resourceAuditLogRead,
 		UpdateContext: resourceAuditLogUpdate,
 		DeleteContext: resourceAuditLogDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(defaultOperationTimeout),
+			Read:   schema.DefaultTimeout(readOperationTimeout),
+			Update: schema.DefaultTimeout(defaultOperationTimeout),
+			Delete: schema.DefaultTimeout(defaultOperationTimeout),
+		},
 		Schema: map[string]*schema.Schema{
 			auditLogNameFieldName: {
 				Type:        schema.TypeString,
@@ -76,16 +86,20 @@ func resourceAuditLog() *schema.Resource {
 // resourceAuditLogRead will gather information from the Terraform store for Oasis Audit Log resource and display it accordingly.
 func resourceAuditLogRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	client := m.(*Client)
-	if err := client.Connect(); err != nil {
-		client.log.Error().Err(err).Msg("Failed to connect to api")
+	if err := client.Connect(ctx); err != nil {
+		tflog.Error(ctx, "Failed to connect to api", map[string]interface{}{"error": err})
 		return diag.FromErr(err)
 	}
 
 	auditc := audit.NewAuditServiceClient(client.conn)
-	auditLog, err := auditc.GetAuditLog(client.ctxWithToken, &common.IDOptions{Id: d.Id()})
-	if err != nil || auditLog == nil {
-		client.log.Error().Err(err).Str("auditLog-id", d.Id()).Msg("Failed to find AuditLog")
+	auditLog, err := auditc.GetAuditLog(client.apiContext(ctx), &common.IDOptions{Id: d.Id()})
+	if isRemoved(auditLog, err) {
+		tflog.Warn(ctx, "Audit log has been deleted, removing it from state", map[string]interface{}{"auditlog-id": d.Id()})
 		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		tflog.Error(ctx, "Failed to find audit log", map[string]interface{}{"error": err, "auditlog-id": d.Id()})
 		return diag.FromErr(err)
 	}
 
@@ -103,8 +117,8 @@ func resourceAuditLogRead(ctx context.Context, d *schema.ResourceData, m interfa
 // in order to create this object.
 func resourceAuditLogCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	client := m.(*Client)
-	if err := client.Connect(); err != nil {
-		client.log.Error().Err(err).Msg("Failed to connect to api")
+	if err := client.Connect(ctx); err != nil {
+		tflog.Error(ctx, "Failed to connect to api", map[string]interface{}{"error": err})
 		return diag.FromErr(err)
 	}
 	auditc := audit.NewAuditServiceClient(client.conn)
@@ -113,16 +127,16 @@ func resourceAuditLogCreate(ctx context.Context, d *schema.ResourceData, m inter
 		return diag.FromErr(err)
 	}
 
-	result, err := auditc.CreateAuditLog(client.ctxWithToken, expanded)
+	result, err := auditc.CreateAuditLog(client.apiContext(ctx), expanded)
 	if err != nil {
-		client.log.Error().Err(err).Msg("Failed to create auditlog")
+		tflog.Error(ctx, "Failed to create auditlog", map[string]interface{}{"error": err})
 		return diag.FromErr(err)
 	}
 
 	if v, ok := d.GetOk(auditLogIsDefaultFieldName); ok {
 		isDefault := v.(bool)
 		if isDefault {
-			if _, err := auditc.SetDefaultAuditLog(client.ctxWithToken, &audit.SetDefaultAuditLogRequest{
+			if _, err := auditc.SetDefaultAuditLog(client.apiContext(ctx), &audit.SetDefaultAuditLogRequest{
 				OrganizationId: result.GetOrganizationId(),
 				AuditlogId:     result.GetId(),
 			}); err != nil {
@@ -162,14 +176,14 @@ func expandAuditLogResource(d *schema.ResourceData) (*audit.AuditLog, error) {
 // resourceAuditLogDelete will delete a given AuditLog resource based on the given ID
 func resourceAuditLogDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	client := m.(*Client)
-	if err := client.Connect(); err != nil {
-		client.log.Error().Err(err).Msg("Failed to connect to api")
+	if err := client.Connect(ctx); err != nil {
+		tflog.Error(ctx, "Failed to connect to api", map[string]interface{}{"error": err})
 		return diag.FromErr(err)
 	}
 
 	auditc := audit.NewAuditServiceClient(client.conn)
-	if _, err := auditc.DeleteAuditLog(client.ctxWithToken, &common.IDOptions{Id: d.Id()}); err != nil {
-		client.log.Error().Err(err).Str("auditlog-id", d.Id()).Msg("Failed to delete Audit Log")
+	if _, err := auditc.DeleteAuditLog(client.apiContext(ctx), &common.IDOptions{Id: d.Id()}); err != nil {
+		tflog.Error(ctx, "Failed to delete Audit Log", map[string]interface{}{"error": err, "auditlog-id": d.Id()})
 		return diag.FromErr(err)
 	}
 	d.SetId("") // called automatically, but added to be explicit
@@ -179,16 +193,19 @@ func resourceAuditLogDelete(ctx context.Context, d *schema.ResourceData, m inter
 // resourceAuditLogUpdate will take a resource diff and apply changes accordingly if there are any.
 func resourceAuditLogUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	client := m.(*Client)
-	if err := client.Connect(); err != nil {
-		client.log.Error().Err(err).Msg("Failed to connect to api")
+	if err := client.Connect(ctx); err != nil {
+		tflog.Error(ctx, "Failed to connect to api", map[string]interface{}{"error": err})
 		return diag.FromErr(err)
 	}
 
 	auditc := audit.NewAuditServiceClient(client.conn)
-	auditLog, err := auditc.GetAuditLog(client.ctxWithToken, &common.IDOptions{Id: d.Id()})
+	auditLog, err := auditc.GetAuditLog(client.apiContext(ctx), &common.IDOptions{Id: d.Id()})
+	if isRemoved(auditLog, err) {
+		tflog.Warn(ctx, "Audit log has been deleted, it is removed from state by the next refresh", map[string]interface{}{"auditlog-id": d.Id()})
+		return diag.Errorf("audit log %s has been deleted", d.Id())
+	}
 	if err != nil {
-		client.log.Error().Err(err).Msg("Failed to find AuditLog")
-		d.SetId("")
+		tflog.Error(ctx, "Failed to find AuditLog", map[string]interface{}{"error": err, "auditlog-id": d.Id()})
 		return diag.FromErr(err)
 	}
 	isChangedDefault := false
@@ -215,14 +232,14 @@ func resourceAuditLogUpdate(ctx context.Context, d *schema.ResourceData, m inter
 		}
 	}
 
-	res, err := auditc.UpdateAuditLog(client.ctxWithToken, auditLog)
+	res, err := auditc.UpdateAuditLog(client.apiContext(ctx), auditLog)
 	if err != nil {
-		client.log.Error().Err(err).Msg("Failed to update AuditLog")
+		tflog.Error(ctx, "Failed to update AuditLog", map[string]interface{}{"error": err})
 		return diag.FromErr(err)
 	}
 
 	if isChangedDefault {
-		if _, err := auditc.SetDefaultAuditLog(client.ctxWithToken, &audit.SetDefaultAuditLogRequest{
+		if _, err := auditc.SetDefaultAuditLog(client.apiContext(ctx), &audit.SetDefaultAuditLogRequest{
 			OrganizationId: auditLog.GetOrganizationId(),
 			AuditlogId:     newDefaultAuditLogID,
 		}); err != nil {
@@ -241,5 +258,6 @@ func flattenAuditLogResource(auditLog *audit.AuditLog) map[string]interface{} {
 		auditLogNameFieldName:         auditLog.GetName(),
 		auditLogDescriptionFieldName:  auditLog.GetDescription(),
 		auditLogOrganizationFieldName: auditLog.GetOrganizationId(),
+		auditLogIsDefaultFieldName:    auditLog.GetIsDefault(),
 	}
 }
@@ -35,12 +35,14 @@ func TestFlattenAuditLog(t *testing.T) {
 		Name:           "test-auditlog",
 		Description:    "test-description",
 		OrganizationId: "9047335679",
+		IsDefault:      true,
 	}
 
 	expected := map[string]interface{}{
 		auditLogNameFieldName:         "test-auditlog",
 		auditLogDescriptionFieldName:  "test-description",
 		auditLogOrganizationFieldName: "9047335679",
+		auditLogIsDefaultFieldName:    true,
 	}
 
 	flattened := flattenAuditLogResource(auditLog)
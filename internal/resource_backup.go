@@ -26,6 +26,7 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"google.golang.org/protobuf/types/known/timestamppb"
@@ -54,6 +55,15 @@ func resourceBackup() *schema.Resource {
 		ReadContext:   resourceBackupRead,
 		UpdateContext: resourceBackupUpdate,
 		DeleteContext: resourceBackupDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(longOperationTimeout),
+			Read:   schema.DefaultTimeout(readOperationTimeout),
+			Update: schema.DefaultTimeout(longOperationTimeout),
+			Delete: schema.DefaultTimeout(longOperationTimeout),
+		},
 		Schema: map[string]*schema.Schema{
 			backupNameFieldName: {
 				Type:        schema.TypeString,
@@ -112,16 +122,20 @@ func resourceBackup() *schema.Resource {
 // resourceBackupRead will gather information from the Terraform store and display it accordingly.
 func resourceBackupRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	client := m.(*Client)
-	if err := client.Connect(); err != nil {
-		client.log.Error().Err(err).Msg("Failed to connect to api")
+	if err := client.Connect(ctx); err != nil {
+		tflog.Error(ctx, "Failed to connect to api", map[string]interface{}{"error": err})
 		return diag.FromErr(err)
 	}
 
 	backupc := backup.NewBackupServiceClient(client.conn)
-	backup, err := backupc.GetBackup(client.ctxWithToken, &common.IDOptions{Id: d.Id()})
-	if err != nil || backup == nil {
-		client.log.Error().Err(err).Str("backup-id", d.Id()).Msg("Failed to find backup")
+	backup, err := backupc.GetBackup(client.apiContext(ctx), &common.IDOptions{Id: d.Id()})
+	if isRemoved(backup, err) {
+		tflog.Warn(ctx, "Backup has been deleted, removing it from state", map[string]interface{}{"backup-id": d.Id()})
 		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		tflog.Error(ctx, "Failed to find backup", map[string]interface{}{"error": err, "backup-id": d.Id()})
 		return diag.FromErr(err)
 	}
 
@@ -168,27 +182,27 @@ func expandBackupResource(d *schema.ResourceData) (*backup.Backup, error) {
 // in order to create this object.
 func resourceBackupCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	client := m.(*Client)
-	if err := client.Connect(); err != nil {
-		client.log.Error().Err(err).Msg("Failed to connect to api")
+	if err := client.Connect(ctx); err != nil {
+		tflog.Error(ctx, "Failed to connect to api", map[string]interface{}{"error": err})
 		return diag.FromErr(err)
 	}
 
 	backupc := backup.NewBackupServiceClient(client.conn)
 	expandedBackup, err := expandBackupResource(d)
 	if err != nil {
-		client.log.Error().Err(err).Msg("Failed to expand on backup")
+		tflog.Error(ctx, "Failed to expand on backup", map[string]interface{}{"error": err})
 		return diag.FromErr(err)
 	}
 
 	// Pre-check for the given deployment
 	datac := data.NewDataServiceClient(client.conn)
-	if _, err := datac.GetDeployment(client.ctxWithToken, &common.IDOptions{Id: expandedBackup.DeploymentId}); err != nil {
-		client.log.Error().Err(err).Str("deployment-id", expandedBackup.DeploymentId).Msg("Deployment with ID not found.")
+	if _, err := datac.GetDeployment(client.apiContext(ctx), &common.IDOptions{Id: expandedBackup.DeploymentId}); err != nil {
+		tflog.Error(ctx, "Deployment with ID not found.", map[string]interface{}{"error": err, "deployment-id": expandedBackup.DeploymentId})
 		return diag.FromErr(err)
 	}
 
-	if b, err := backupc.CreateBackup(client.ctxWithToken, expandedBackup); err != nil {
-		client.log.Error().Err(err).Msg("Failed to create backup")
+	if b, err := backupc.CreateBackup(client.apiContext(ctx), expandedBackup); err != nil {
+		tflog.Error(ctx, "Failed to create backup", map[string]interface{}{"error": err})
 		return diag.FromErr(err)
 	} else {
 		d.SetId(b.GetId())
@@ -200,14 +214,14 @@ func resourceBackupCreate(ctx context.Context, d *schema.ResourceData, m interfa
 // resourceBackupDelete will delete a given resource based on the calculated ID.
 func resourceBackupDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	client := m.(*Client)
-	if err := client.Connect(); err != nil {
-		client.log.Error().Err(err).Msg("Failed to connect to api")
+	if err := client.Connect(ctx); err != nil {
+		tflog.Error(ctx, "Failed to connect to api", map[string]interface{}{"error": err})
 		return diag.FromErr(err)
 	}
 
 	backupc := backup.NewBackupServiceClient(client.conn)
-	if _, err := backupc.DeleteBackup(client.ctxWithToken, &common.IDOptions{Id: d.Id()}); err != nil {
-		client.log.Error().Err(err).Str("backup-id", d.Id()).Msg("Failed to delete backup")
+	if _, err := backupc.DeleteBackup(client.apiContext(ctx), &common.IDOptions{Id: d.Id()}); err != nil {
+		tflog.Error(ctx, "Failed to delete backup", map[string]interface{}{"error": err, "backup-id": d.Id()})
 		return diag.FromErr(err)
 	}
 	d.SetId("") // called automatically, but added to be explicit
@@ -217,15 +231,18 @@ func resourceBackupDelete(ctx context.Context, d *schema.ResourceData, m interfa
 // resourceBackupUpdate will take a resource diff and apply changes accordingly if there are any.
 func resourceBackupUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	client := m.(*Client)
-	if err := client.Connect(); err != nil {
-		client.log.Error().Err(err).Msg("Failed to connect to api")
+	if err := client.Connect(ctx); err != nil {
+		tflog.Error(ctx, "Failed to connect to api", map[string]interface{}{"error": err})
 		return diag.FromErr(err)
 	}
 	backupc := backup.NewBackupServiceClient(client.conn)
-	backup, err := backupc.GetBackup(client.ctxWithToken, &common.IDOptions{Id: d.Id()})
+	backup, err := backupc.GetBackup(client.apiContext(ctx), &common.IDOptions{Id: d.Id()})
+	if isRemoved(backup, err) {
+		tflog.Warn(ctx, "Backup has been deleted, it is removed from state by the next refresh", map[string]interface{}{"backup-id": d.Id()})
+		return diag.Errorf("backup %s has been deleted", d.Id())
+	}
 	if err != nil {
-		client.log.Error().Err(err).Msg("Failed to find backup")
-		d.SetId("")
+		tflog.Error(ctx, "Failed to find backup", map[string]interface{}{"error": err, "backup-id": d.Id()})
 		return diag.FromErr(err)
 	}
 	// Main fields
@@ -246,9 +263,9 @@ func resourceBackupUpdate(ctx context.Context, d *schema.ResourceData, m interfa
 		backup.AutoDeletedAt = updatedAutoDeleteAt
 	}
 
-	res, err := backupc.UpdateBackup(client.ctxWithToken, backup)
+	res, err := backupc.UpdateBackup(client.apiContext(ctx), backup)
 	if err != nil {
-		client.log.Error().Err(err).Msg("Failed to update backup")
+		tflog.Error(ctx, "Failed to update backup", map[string]interface{}{"error": err})
 		return diag.FromErr(err)
 	} else {
 		d.SetId(res.GetId())
@@ -261,6 +278,7 @@ func flattenBackupResource(backup *backup.Backup) map[string]interface{} {
 	return map[string]interface{}{
 		backupNameFieldName:         backup.GetName(),
 		backupDescriptionFieldName:  backup.GetDescription(),
+		backupUploadFieldName:       backup.GetUpload(),
 		backupURLFieldName:          backup.GetUrl(),
 		backupPolicyIDFieldName:     backup.GetBackupPolicyId(),
 		backupDeploymentIDFieldName: backup.GetDeploymentId(),
@@ -25,6 +25,7 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"google.golang.org/protobuf/types/known/durationpb"
@@ -88,6 +89,15 @@ func resourceBackupPolicy() *schema.Resource {
 		ReadContext:   resourceBackupPolicyRead,
 		UpdateContext: resourceBackupPolicyUpdate,
 		DeleteContext: resourceBackupPolicyDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(defaultOperationTimeout),
+			Read:   schema.DefaultTimeout(readOperationTimeout),
+			Update: schema.DefaultTimeout(defaultOperationTimeout),
+			Delete: schema.DefaultTimeout(defaultOperationTimeout),
+		},
 
 		CustomizeDiff: func(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
 			o, n := diff.GetChange(backupPolicyDeploymentIDFieldName)
@@ -296,15 +306,18 @@ func resourceBackupPolicy() *schema.Resource {
 // resourceBackupPolicyUpdate will take a resource diff and apply changes accordingly if there are any.
 func resourceBackupPolicyUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	client := m.(*Client)
-	if err := client.Connect(); err != nil {
-		client.log.Error().Err(err).Msg("Failed to connect to api")
+	if err := client.Connect(ctx); err != nil {
+		tflog.Error(ctx, "Failed to connect to api", map[string]interface{}{"error": err})
 		return diag.FromErr(err)
 	}
 	backupc := backup.NewBackupServiceClient(client.conn)
-	policy, err := backupc.GetBackupPolicy(client.ctxWithToken, &common.IDOptions{Id: d.Id()})
+	policy, err := backupc.GetBackupPolicy(client.apiContext(ctx), &common.IDOptions{Id: d.Id()})
+	if isRemoved(policy, err) {
+		tflog.Warn(ctx, "Backup policy has been deleted, it is removed from state by the next refresh", map[string]interface{}{"backup-policy-id": d.Id()})
+		return diag.Errorf("backup policy %s has been deleted", d.Id())
+	}
 	if err != nil {
-		client.log.Error().Err(err).Msg("Failed to find backup policy")
-		d.SetId("")
+		tflog.Error(ctx, "Failed to find backup policy", map[string]interface{}{"error": err, "backup-policy-id": d.Id()})
 		return diag.FromErr(err)
 	}
 	// Main fields
@@ -357,9 +370,9 @@ func resourceBackupPolicyUpdate(ctx context.Context, d *schema.ResourceData, m i
 		policy.Locked = d.Get(backupPolicyLockedFieldName).(bool)
 	}
 
-	res, err := backupc.UpdateBackupPolicy(client.ctxWithToken, policy)
+	res, err := backupc.UpdateBackupPolicy(client.apiContext(ctx), policy)
 	if err != nil {
-		client.log.Error().Err(err).Msg("Failed to update backup policy")
+		tflog.Error(ctx, "Failed to update backup policy", map[string]interface{}{"error": err})
 		return diag.FromErr(err)
 	} else {
 		d.SetId(res.GetId())
@@ -376,23 +389,22 @@ func getRetentionPeriod(v interface{}) *durationpb.Duration {
 // resourceBackupPolicyRead will gather information from the terraform store and display it accordingly.
 func resourceBackupPolicyRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	client := m.(*Client)
-	if err := client.Connect(); err != nil {
-		client.log.Error().Err(err).Msg("Failed to connect to api")
+	if err := client.Connect(ctx); err != nil {
+		tflog.Error(ctx, "Failed to connect to api", map[string]interface{}{"error": err})
 		return diag.FromErr(err)
 	}
 
 	backupc := backup.NewBackupServiceClient(client.conn)
-	policy, err := backupc.GetBackupPolicy(client.ctxWithToken, &common.IDOptions{Id: d.Id()})
-	if err != nil {
-		client.log.Error().Err(err).Str("backup-policy-id", d.Id()).Msg("Failed to find backup policy")
-		d.SetId("")
-		return diag.FromErr(err)
-	}
-	if policy == nil {
-		client.log.Error().Err(err).Str("backup-policy-id", d.Id()).Msg("Failed to find backup policy")
+	policy, err := backupc.GetBackupPolicy(client.apiContext(ctx), &common.IDOptions{Id: d.Id()})
+	if isRemoved(policy, err) {
+		tflog.Warn(ctx, "Backup policy has been deleted, removing it from state", map[string]interface{}{"backup-policy-id": d.Id()})
 		d.SetId("")
 		return nil
 	}
+	if err != nil {
+		tflog.Error(ctx, "Failed to find backup policy", map[string]interface{}{"error": err, "backup-policy-id": d.Id()})
+		return diag.FromErr(err)
+	}
 
 	for k, v := range flattenBackupPolicyResource(policy) {
 		if err := d.Set(k, v); err != nil {
@@ -654,25 +666,25 @@ func expandHourlySchedule(s []interface{}) *backup.BackupPolicy_HourlySchedule {
 // in order to create this object.
 func resourceBackupPolicyCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	client := m.(*Client)
-	if err := client.Connect(); err != nil {
-		client.log.Error().Err(err).Msg("Failed to connect to api")
+	if err := client.Connect(ctx); err != nil {
+		tflog.Error(ctx, "Failed to connect to api", map[string]interface{}{"error": err})
 		return diag.FromErr(err)
 	}
 
 	backupc := backup.NewBackupServiceClient(client.conn)
 	expandedPolicy, err := expandBackupPolicyResource(d)
 	if err != nil {
-		client.log.Error().Err(err).Msg("Failed to expand on policy")
+		tflog.Error(ctx, "Failed to expand on policy", map[string]interface{}{"error": err})
 		return diag.FromErr(err)
 	}
 	// Pre-check for the given deployment
 	datac := data.NewDataServiceClient(client.conn)
-	if _, err := datac.GetDeployment(client.ctxWithToken, &common.IDOptions{Id: expandedPolicy.DeploymentId}); err != nil {
-		client.log.Error().Err(err).Str("deployment-id", expandedPolicy.DeploymentId).Msg("Deployment with ID not found.")
+	if _, err := datac.GetDeployment(client.apiContext(ctx), &common.IDOptions{Id: expandedPolicy.DeploymentId}); err != nil {
+		tflog.Error(ctx, "Deployment with ID not found.", map[string]interface{}{"error": err, "deployment-id": expandedPolicy.DeploymentId})
 		return diag.FromErr(err)
 	}
-	if b, err := backupc.CreateBackupPolicy(client.ctxWithToken, expandedPolicy); err != nil {
-		client.log.Error().Err(err).Msg("Failed to create backup policy")
+	if b, err := backupc.CreateBackupPolicy(client.apiContext(ctx), expandedPolicy); err != nil {
+		tflog.Error(ctx, "Failed to create backup policy", map[string]interface{}{"error": err})
 		return diag.FromErr(err)
 	} else {
 		d.SetId(b.GetId())
@@ -683,14 +695,14 @@ func resourceBackupPolicyCreate(ctx context.Context, d *schema.ResourceData, m i
 // resourceBackupPolicyDelete will delete a given resource based on the calculated ID.
 func resourceBackupPolicyDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	client := m.(*Client)
-	if err := client.Connect(); err != nil {
-		client.log.Error().Err(err).Msg("Failed to connect to api")
+	if err := client.Connect(ctx); err != nil {
+		tflog.Error(ctx, "Failed to connect to api", map[string]interface{}{"error": err})
 		return diag.FromErr(err)
 	}
 
 	backupc := backup.NewBackupServiceClient(client.conn)
-	if _, err := backupc.DeleteBackupPolicy(client.ctxWithToken, &common.IDOptions{Id: d.Id()}); err != nil {
-		client.log.Error().Err(err).Str("backup-policy-id", d.Id()).Msg("Failed to delete backup policy")
+	if _, err := backupc.DeleteBackupPolicy(client.apiContext(ctx), &common.IDOptions{Id: d.Id()}); err != nil {
+		tflog.Error(ctx, "Failed to delete backup policy", map[string]interface{}{"error": err, "backup-policy-id": d.Id()})
 		return diag.FromErr(err)
 	}
 	d.SetId("") // called automatically, but added to be explicit
@@ -28,6 +28,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -80,6 +81,12 @@ func TestAccResourceBackup(t *testing.T) {
 					resource.TestCheckResourceAttr("oasis_backup."+res, backupAutoDeleteAtFieldName, "3"),
 				),
 			},
+			{
+				ResourceName:            "oasis_backup." + res,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{backupAutoDeleteAtFieldName},
+			},
 		},
 	})
 }
@@ -217,11 +224,13 @@ func TestFlattenBackup(t *testing.T) {
 		BackupPolicyId: "456123",
 		Url:            "test-url",
 		RegionId:       "gcp-europe-west-4",
+		Upload:         true,
 	}
 
 	expected := map[string]interface{}{
 		backupNameFieldName:         "test-backup",
 		backupDescriptionFieldName:  "test-description",
+		backupUploadFieldName:       true,
 		backupDeploymentIDFieldName: "123456",
 		backupPolicyIDFieldName:     "456123",
 		backupURLFieldName:          "test-url",
@@ -234,9 +243,10 @@ func TestFlattenBackup(t *testing.T) {
 
 // testAccCheckDestroyBackup verifies the Terraform oasis_backup resource cleanup.
 func testAccCheckDestroyBackup(s *terraform.State) error {
+	ctx := context.Background()
 	client := testAccProvider.Meta().(*Client)
-	if err := client.Connect(); err != nil {
-		client.log.Error().Err(err).Msg("Failed to connect to api")
+	if err := client.Connect(ctx); err != nil {
+		tflog.Error(ctx, "Failed to connect to api", map[string]interface{}{"error": err})
 		return err
 	}
 	backupc := backup.NewBackupServiceClient(client.conn)
@@ -246,7 +256,7 @@ func testAccCheckDestroyBackup(s *terraform.State) error {
 			continue
 		}
 
-		if _, err := backupc.GetBackup(client.ctxWithToken, &common.IDOptions{Id: rs.Primary.ID}); !common.IsNotFound(err) {
+		if _, err := backupc.GetBackup(client.apiContext(ctx), &common.IDOptions{Id: rs.Primary.ID}); !common.IsNotFound(err) {
 			return fmt.Errorf("backup still present")
 		}
 	}
@@ -24,6 +24,7 @@ import (
 	"context"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"google.golang.org/protobuf/types/known/durationpb"
@@ -53,6 +54,15 @@ func resourceCertificate() *schema.Resource {
 		ReadContext:   resourceCertificateRead,
 		UpdateContext: resourceCertificateUpdate,
 		DeleteContext: resourceCertificateDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(defaultOperationTimeout),
+			Read:   schema.DefaultTimeout(readOperationTimeout),
+			Update: schema.DefaultTimeout(defaultOperationTimeout),
+			Delete: schema.DefaultTimeout(defaultOperationTimeout),
+		},
 
 		Schema: map[string]*schema.Schema{
 			nameFieldName: {
@@ -65,6 +75,7 @@ func resourceCertificate() *schema.Resource {
 				Type:        schema.TypeString,
 				Description: "CA Certificate Resource Project Name field",
 				Optional:    true,
+				Computed:    true,
 			},
 
 			descriptionFieldName: {
@@ -77,6 +88,7 @@ func resourceCertificate() *schema.Resource {
 				Type:        schema.TypeInt,
 				Description: "CA Certificate Resource Certificate Lifetime field",
 				Optional:    true,
+				Computed:    true,
 			},
 
 			useWellKnownCertificateFieldName: {
@@ -114,15 +126,15 @@ func resourceCertificate() *schema.Resource {
 // sets the ID of a given certificate once the creation is successful. This will be stored in local terraform store.
 func resourceCertificateCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	client := m.(*Client)
-	if err := client.Connect(); err != nil {
-		client.log.Error().Err(err).Msg("Failed to connect to api")
+	if err := client.Connect(ctx); err != nil {
+		tflog.Error(ctx, "Failed to connect to api", map[string]interface{}{"error": err})
 		return diag.FromErr(err)
 	}
 	cryptoc := crypto.NewCryptoServiceClient(client.conn)
 
-	result, err := cryptoc.CreateCACertificate(client.ctxWithToken, expandToCertificate(d))
+	result, err := cryptoc.CreateCACertificate(client.apiContext(ctx), expandToCertificate(d))
 	if err != nil {
-		client.log.Error().Err(err).Msg("Failed to create certificate")
+		tflog.Error(ctx, "Failed to create certificate", map[string]interface{}{"error": err})
 		return diag.FromErr(err)
 	}
 	if result != nil {
@@ -135,28 +147,26 @@ func resourceCertificateCreate(ctx context.Context, d *schema.ResourceData, m in
 // This function should always be called from create and update.
 func resourceCertificateRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	client := m.(*Client)
-	if err := client.Connect(); err != nil {
-		client.log.Error().Err(err).Msg("Failed to connect to api")
+	if err := client.Connect(ctx); err != nil {
+		tflog.Error(ctx, "Failed to connect to api", map[string]interface{}{"error": err})
 		return diag.FromErr(err)
 	}
 
 	cryptoc := crypto.NewCryptoServiceClient(client.conn)
-	cert, err := cryptoc.GetCACertificate(client.ctxWithToken, &common.IDOptions{Id: d.Id()})
-	if err != nil {
-		client.log.Error().Err(err).Str("certificate-id", d.Id()).Msg("Failed to find certificate")
-		return diag.FromErr(err)
-	}
-	if cert == nil {
-		client.log.Error().Str("certificate-id", d.Id()).Msg("Failed to find certificate")
+	cert, err := cryptoc.GetCACertificate(client.apiContext(ctx), &common.IDOptions{Id: d.Id()})
+	if isRemoved(cert, err) {
+		tflog.Warn(ctx, "Certificate has been deleted, removing it from state", map[string]interface{}{"certificate-id": d.Id()})
 		d.SetId("")
 		return nil
 	}
+	if err != nil {
+		tflog.Error(ctx, "Failed to find certificate", map[string]interface{}{"error": err, "certificate-id": d.Id()})
+		return diag.FromErr(err)
+	}
 
 	for k, v := range flattenCertificateResource(cert) {
-		if _, ok := d.GetOk(k); ok {
-			if err := d.Set(k, v); err != nil {
-				return diag.FromErr(err)
-			}
+		if err := d.Set(k, v); err != nil {
+			return diag.FromErr(err)
 		}
 	}
 	return nil
@@ -218,22 +228,21 @@ func expandToCertificate(d *schema.ResourceData) *crypto.CACertificate {
 // Only relevant fields are checked for update. Computed fields are ignored.
 func resourceCertificateUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	client := m.(*Client)
-	if err := client.Connect(); err != nil {
-		client.log.Error().Err(err).Msg("Failed to connect to api")
+	if err := client.Connect(ctx); err != nil {
+		tflog.Error(ctx, "Failed to connect to api", map[string]interface{}{"error": err})
 		return diag.FromErr(err)
 	}
 
 	cryptoc := crypto.NewCryptoServiceClient(client.conn)
-	cert, err := cryptoc.GetCACertificate(client.ctxWithToken, &common.IDOptions{Id: d.Id()})
+	cert, err := cryptoc.GetCACertificate(client.apiContext(ctx), &common.IDOptions{Id: d.Id()})
+	if isRemoved(cert, err) {
+		tflog.Warn(ctx, "Certificate has been deleted, it is removed from state by the next refresh", map[string]interface{}{"certificate-id": d.Id()})
+		return diag.Errorf("certificate %s has been deleted", d.Id())
+	}
 	if err != nil {
-		client.log.Error().Err(err).Msg("Failed get certificate")
+		tflog.Error(ctx, "Failed get certificate", map[string]interface{}{"error": err, "certificate-id": d.Id()})
 		return diag.FromErr(err)
 	}
-	if cert == nil {
-		client.log.Error().Str("certificate-id", d.Id()).Msg("Failed to find certificate")
-		d.SetId("")
-		return nil
-	}
 
 	if d.HasChange(nameFieldName) {
 		cert.Name = d.Get(nameFieldName).(string)
@@ -250,9 +259,9 @@ func resourceCertificateUpdate(ctx context.Context, d *schema.ResourceData, m in
 	if d.HasChange(lockedFieldName) {
 		cert.Locked = d.Get(lockedFieldName).(bool)
 	}
-	res, err := cryptoc.UpdateCACertificate(client.ctxWithToken, cert)
+	res, err := cryptoc.UpdateCACertificate(client.apiContext(ctx), cert)
 	if err != nil {
-		client.log.Error().Err(err).Str("certificate-id", d.Id()).Msg("Failed to update certificate")
+		tflog.Error(ctx, "Failed to update certificate", map[string]interface{}{"error": err, "certificate-id": d.Id()})
 		return diag.FromErr(err)
 	}
 	d.SetId(res.Id)
@@ -262,14 +271,14 @@ func resourceCertificateUpdate(ctx context.Context, d *schema.ResourceData, m in
 // resourceCertificateDelete will be called once the resource is destroyed.
 func resourceCertificateDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	client := m.(*Client)
-	if err := client.Connect(); err != nil {
-		client.log.Error().Err(err).Msg("Failed to connect to api")
+	if err := client.Connect(ctx); err != nil {
+		tflog.Error(ctx, "Failed to connect to api", map[string]interface{}{"error": err})
 		return diag.FromErr(err)
 	}
 
 	cryptoc := crypto.NewCryptoServiceClient(client.conn)
-	if _, err := cryptoc.DeleteCACertificate(client.ctxWithToken, &common.IDOptions{Id: d.Id()}); err != nil {
-		client.log.Error().Err(err).Str("certificate-id", d.Id()).Msg("Failed to delete certificate")
+	if _, err := cryptoc.DeleteCACertificate(client.apiContext(ctx), &common.IDOptions{Id: d.Id()}); err != nil {
+		tflog.Error(ctx, "Failed to delete certificate", map[string]interface{}{"error": err, "certificate-id": d.Id()})
 		return diag.FromErr(err)
 	}
 	d.SetId("") // called automatically, but added to be explicit
@@ -30,6 +30,7 @@ import (
 	"google.golang.org/protobuf/types/known/durationpb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -83,6 +84,11 @@ func TestResourceCertificate(t *testing.T) {
 					resource.TestCheckResourceAttr("oasis_certificate."+res, nameFieldName, certName),
 				),
 			},
+			{
+				ResourceName:      "oasis_certificate." + res,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
@@ -141,9 +147,10 @@ func TestExpandingCertificateResource(t *testing.T) {
 
 // testAccCheckDestroyCertificate verifies the Terraform oasis_certificate resource cleanup.
 func testAccCheckDestroyCertificate(s *terraform.State) error {
+	ctx := context.Background()
 	client := testAccProvider.Meta().(*Client)
-	if err := client.Connect(); err != nil {
-		client.log.Error().Err(err).Msg("Failed to connect to api")
+	if err := client.Connect(ctx); err != nil {
+		tflog.Error(ctx, "Failed to connect to api", map[string]interface{}{"error": err})
 		return err
 	}
 	cryptoc := crypto.NewCryptoServiceClient(client.conn)
@@ -153,7 +160,7 @@ func testAccCheckDestroyCertificate(s *terraform.State) error {
 			continue
 		}
 
-		if _, err := cryptoc.GetCACertificate(client.ctxWithToken, &common.IDOptions{Id: rs.Primary.ID}); err == nil {
+		if _, err := cryptoc.GetCACertificate(client.apiContext(ctx), &common.IDOptions{Id: rs.Primary.ID}); err == nil {
 			return fmt.Errorf("certificate still present")
 		}
 	}
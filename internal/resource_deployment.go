@@ -25,9 +25,16 @@ import (
 	"errors"
 	"fmt"
 	"sort"
+	"strings"
+	"time"
 
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
 	common "github.com/arangodb-managed/apis/common/v1"
 	crypto "github.com/arangodb-managed/apis/crypto/v1"
@@ -54,14 +61,72 @@ const (
 	deplConfigurationNodeCountFieldName                  = "node_count"
 	deplConfigurationNodeDiskSizeFieldName               = "node_disk_size"
 	deplConfigurationMaximumNodeDiskSizeFieldName        = "maximum_node_disk_size"
+	deplConfigurationNodeSizeSelectorFieldName           = "node_size_selector"
+	deplNodeSizeSelectorMinMemorySizeFieldName           = "min_memory_size"
+	deplNodeSizeSelectorMinCPUSizeFieldName              = "min_cpu_size"
+	deplNodeSizeSelectorMinDiskSizeFieldName             = "min_disk_size"
+	deplNodeSizeSelectorPreferenceFieldName              = "preference"
 	deplNotificationConfigurationFieldName               = "notification_settings"
 	deplNotificationConfigurationEmailAddressesFieldName = "email_addresses"
 	deplDiskPerformanceFieldName                         = "disk_performance"
 	deplDisableScheduledRootPasswordRotationFieldName    = "disable_scheduled_root_password_rotation"
+	deplLastRootPasswordRotatedAtFieldName               = "last_root_password_rotated_at"
 	deplLockedFieldName                                  = "locked"
 	deplDeploymentProfileIDFieldName                     = "deployment_profile_id"
 	deplIsPlatformAuthEnabled                            = "is_platform_authentication_enabled"
 	deplDropVSTSupportFieldName                          = "drop_vst_support"
+	deplIsPausedFieldName                                = "is_paused"
+	deplLastPausedAtFieldName                            = "last_paused_at"
+	deplWaitForReadyFieldName                            = "wait_for_ready"
+	deplCloneFromBackupIDFieldName                       = "clone_from_backup_id"
+	deplVersionUpgradeFieldName                          = "version_upgrade"
+	deplSelectedNodeSizeIDFieldName                      = "selected_node_size_id"
+	deplStatusFieldName                                  = "status"
+	deplStatusPhaseFieldName                             = "phase"
+	deplStatusDescriptionFieldName                       = "description"
+	deplStatusReadyFieldName                             = "ready"
+	deplStatusBootstrappedFieldName                      = "bootstrapped"
+	deplStatusUpgradingFieldName                         = "upgrading"
+	deplStatusCreatedAtFieldName                         = "created_at"
+	deplStatusServersFieldName                           = "servers"
+	deplStatusServerIDFieldName                          = "id"
+	deplStatusServerTypeFieldName                        = "type"
+	deplStatusServerStateFieldName                       = "state"
+	deplStatusServerReadyFieldName                       = "ready"
+	deplStatusServerVersionFieldName                     = "version"
+	deplEndpointFieldName                                = "endpoint"
+	deplEndpointDefaultFieldName                         = "endpoint_default"
+	deplEndpointSelfSignedFieldName                      = "endpoint_self_signed"
+	deplPrivateEndpointFieldName                         = "private_endpoint"
+	deplPrivateEndpointDefaultFieldName                  = "private_endpoint_default"
+	deplPrivateEndpointSelfSignedFieldName               = "private_endpoint_self_signed"
+	deplCACertificatePEMFieldName                        = "ca_certificate_pem"
+	deplRootUsernameFieldName                            = "root_username"
+	deplRootPasswordFieldName                            = "root_password"
+)
+
+const (
+	// deplCredentialsReason is the reason recorded when reading the root credentials of a deployment
+	deplCredentialsReason = "Read by terraform-provider-oasis"
+)
+
+// Phases of a deployment, as exposed in its status and used to wait for it to become ready
+const (
+	deplPhaseCreating      = "Creating"
+	deplPhaseBootstrapping = "Bootstrapping"
+	deplPhaseUpgrading     = "Upgrading"
+	deplPhaseRestoring     = "Restoring"
+	deplPhaseUpdating      = "Updating"
+	deplPhaseNotReady      = "NotReady"
+	deplPhaseReady         = "Ready"
+	deplPhasePausing       = "Pausing"
+	deplPhasePaused        = "Paused"
+	deplPhaseDeleting      = "Deleting"
+)
+
+const (
+	// deploymentPollInterval is the interval at which a deployment is polled while waiting for it to become ready
+	deploymentPollInterval = 15 * time.Second
 )
 
 func resourceDeployment() *schema.Resource {
@@ -72,6 +137,22 @@ func resourceDeployment() *schema.Resource {
 		ReadContext:   resourceDeploymentRead,
 		UpdateContext: resourceDeploymentUpdate,
 		DeleteContext: resourceDeploymentDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceDeploymentImport,
+		},
+		CustomizeDiff: customdiff.All(
+			defaultDeploymentProjectDiff,
+			resolveDeploymentNodeSizeDiff,
+			validateDeploymentConfigurationDiff,
+			validateDeploymentVersionDiff,
+			validateDeploymentCloneDiff,
+		),
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(longOperationTimeout),
+			Read:   schema.DefaultTimeout(readOperationTimeout),
+			Update: schema.DefaultTimeout(longOperationTimeout),
+			Delete: schema.DefaultTimeout(longOperationTimeout),
+		},
 
 		Schema: map[string]*schema.Schema{
 			deplTAndCAcceptedFieldName: {
@@ -83,6 +164,7 @@ func resourceDeployment() *schema.Resource {
 				Type:        schema.TypeString,
 				Description: "Deployment Resource Deployment Project field",
 				Optional:    true,
+				Computed:    true,
 			},
 
 			deplNameFieldName: {
@@ -186,6 +268,43 @@ func resourceDeployment() *schema.Resource {
 							DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
 								return new == ""
 							},
+							ConflictsWith: []string{deplNodeSizeSelectorPath},
+						},
+						deplConfigurationNodeSizeSelectorFieldName: {
+							Type:          schema.TypeList,
+							Description:   "Deployment Resource Deployment Configuration Node Size Selector field (selects the node size at plan time instead of node_size_id, the selected node size is stored in selected_node_size_id)",
+							Optional:      true,
+							MaxItems:      1,
+							ConflictsWith: []string{deplNodeSizeIdPath},
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									deplNodeSizeSelectorMinMemorySizeFieldName: {
+										Type:         schema.TypeInt,
+										Description:  "Deployment Resource Deployment Configuration Node Size Selector Minimum Memory Size field (in GB)",
+										Optional:     true,
+										ValidateFunc: validation.IntAtLeast(0),
+									},
+									deplNodeSizeSelectorMinCPUSizeFieldName: {
+										Type:         schema.TypeString,
+										Description:  "Deployment Resource Deployment Configuration Node Size Selector Minimum CPU Size field (standard or high)",
+										Optional:     true,
+										ValidateFunc: validation.StringInSlice(nodeSizeCPUSizes, false),
+									},
+									deplNodeSizeSelectorMinDiskSizeFieldName: {
+										Type:         schema.TypeInt,
+										Description:  "Deployment Resource Deployment Configuration Node Size Selector Minimum Disk Size field (in GB, the largest disk size a selected node size supports)",
+										Optional:     true,
+										ValidateFunc: validation.IntAtLeast(0),
+									},
+									deplNodeSizeSelectorPreferenceFieldName: {
+										Type:         schema.TypeString,
+										Description:  "Deployment Resource Deployment Configuration Node Size Selector Preference field (smallest or cheapest of the node sizes which fit)",
+										Optional:     true,
+										Default:      nodeSizePreferenceSmallest,
+										ValidateFunc: validation.StringInSlice([]string{nodeSizePreferenceSmallest, nodeSizePreferenceCheapest}, false),
+									},
+								},
+							},
 						},
 						deplConfigurationNodeCountFieldName: {
 							Type:        schema.TypeInt,
@@ -247,6 +366,11 @@ func resourceDeployment() *schema.Resource {
 				Description: "Deployment Resource Deployment Scheduled Root Password Rotation field",
 				Optional:    true,
 			},
+			deplLastRootPasswordRotatedAtFieldName: {
+				Type:        schema.TypeString,
+				Description: "Deployment Resource Deployment Last Root Password Rotated field (not set until the scheduled root password rotation has been enabled)",
+				Computed:    true,
+			},
 
 			deplLockedFieldName: {
 				Type:        schema.TypeBool,
@@ -272,6 +396,162 @@ func resourceDeployment() *schema.Resource {
 				Optional:    true,
 				Default:     false,
 			},
+			deplIsPausedFieldName: {
+				Type:        schema.TypeBool,
+				Description: "Deployment Resource Deployment Is Paused field (pauses the deployment when set, resumes it when unset)",
+				Optional:    true,
+				Default:     false,
+			},
+			deplLastPausedAtFieldName: {
+				Type:        schema.TypeString,
+				Description: "Deployment Resource Deployment Last Paused field",
+				Computed:    true,
+			},
+			deplWaitForReadyFieldName: {
+				Type:        schema.TypeBool,
+				Description: "Deployment Resource Wait For Ready field (wait until the deployment is ready after it is created or resumed, or after a change of its version, configuration, disk performance or security has been rolled out, and until it is paused after it has been paused)",
+				Optional:    true,
+				Default:     true,
+			},
+			deplCloneFromBackupIDFieldName: {
+				Type:        schema.TypeString,
+				Description: "Deployment Resource Clone From Backup ID field (ID of an uploaded backup the deployment is cloned from when it is created, which determines its version and data; cannot be combined with deployment_profile_id)",
+				Optional:    true,
+				ForceNew:    true,
+				// The backup a deployment was cloned from is not known to the API, e.g. after an import
+				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+					return old == "" && d.Id() != ""
+				},
+			},
+			deplSelectedNodeSizeIDFieldName: {
+				Type:        schema.TypeString,
+				Description: "Deployment Resource Selected Node Size ID field (ID of the node size selected by the node size selector)",
+				Computed:    true,
+			},
+			deplVersionUpgradeFieldName: {
+				Type:        schema.TypeString,
+				Description: "Deployment Resource Version Upgrade field (kind of the latest upgrade of the ArangoDB version: patch, minor or major, followed by (unsupported upgrade path) if the new version is not a supported upgrade of the previous one)",
+				Computed:    true,
+			},
+			deplEndpointFieldName: {
+				Type:        schema.TypeString,
+				Description: "Deployment Resource Deployment Endpoint field (URL of port 8529, using the well known certificate if one is configured)",
+				Computed:    true,
+			},
+			deplEndpointDefaultFieldName: {
+				Type:        schema.TypeString,
+				Description: "Deployment Resource Deployment Endpoint Default field (URL of port 443, recommended for human-to-database connections)",
+				Computed:    true,
+			},
+			deplEndpointSelfSignedFieldName: {
+				Type:        schema.TypeString,
+				Description: "Deployment Resource Deployment Endpoint Self Signed field (URL of the port using the self-signed certificate, recommended for machine-to-database connections)",
+				Computed:    true,
+			},
+			deplPrivateEndpointFieldName: {
+				Type:        schema.TypeString,
+				Description: "Deployment Resource Deployment Private Endpoint field (URL of port 8529 of the private endpoint, empty if none is configured)",
+				Computed:    true,
+			},
+			deplPrivateEndpointDefaultFieldName: {
+				Type:        schema.TypeString,
+				Description: "Deployment Resource Deployment Private Endpoint Default field (URL of port 443 of the private endpoint, empty if none is configured)",
+				Computed:    true,
+			},
+			deplPrivateEndpointSelfSignedFieldName: {
+				Type:        schema.TypeString,
+				Description: "Deployment Resource Deployment Private Endpoint Self Signed field (URL of the port of the private endpoint using the self-signed certificate, which includes the alternate DNS names, empty if none is configured)",
+				Computed:    true,
+			},
+			deplCACertificatePEMFieldName: {
+				Type:        schema.TypeString,
+				Description: "Deployment Resource Deployment CA Certificate PEM field (PEM encoded CA certificate used by the deployment)",
+				Computed:    true,
+			},
+			deplRootUsernameFieldName: {
+				Type:        schema.TypeString,
+				Description: "Deployment Resource Deployment Root Username field",
+				Computed:    true,
+			},
+			deplRootPasswordFieldName: {
+				Type:        schema.TypeString,
+				Description: "Deployment Resource Deployment Root Password field (empty if the credentials may not be read)",
+				Computed:    true,
+				Sensitive:   true,
+			},
+			deplStatusFieldName: {
+				Type:        schema.TypeList,
+				Description: "Deployment Resource Deployment Status field",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						deplStatusPhaseFieldName: {
+							Type:        schema.TypeString,
+							Description: "Deployment Resource Deployment Status Phase field (one of Creating, Bootstrapping, Upgrading, Restoring, Updating, NotReady, Ready, Pausing, Paused or Deleting)",
+							Computed:    true,
+						},
+						deplStatusDescriptionFieldName: {
+							Type:        schema.TypeString,
+							Description: "Deployment Resource Deployment Status Description field",
+							Computed:    true,
+						},
+						deplStatusReadyFieldName: {
+							Type:        schema.TypeBool,
+							Description: "Deployment Resource Deployment Status Ready field",
+							Computed:    true,
+						},
+						deplStatusBootstrappedFieldName: {
+							Type:        schema.TypeBool,
+							Description: "Deployment Resource Deployment Status Bootstrapped field",
+							Computed:    true,
+						},
+						deplStatusUpgradingFieldName: {
+							Type:        schema.TypeBool,
+							Description: "Deployment Resource Deployment Status Upgrading field",
+							Computed:    true,
+						},
+						deplStatusCreatedAtFieldName: {
+							Type:        schema.TypeString,
+							Description: "Deployment Resource Deployment Status Created At field",
+							Computed:    true,
+						},
+						deplStatusServersFieldName: {
+							Type:        schema.TypeList,
+							Description: "Deployment Resource Deployment Status Servers field",
+							Computed:    true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									deplStatusServerIDFieldName: {
+										Type:        schema.TypeString,
+										Description: "Deployment Resource Deployment Status Server ID field",
+										Computed:    true,
+									},
+									deplStatusServerTypeFieldName: {
+										Type:        schema.TypeString,
+										Description: "Deployment Resource Deployment Status Server Type field (agent, coordinator or dbserver)",
+										Computed:    true,
+									},
+									deplStatusServerStateFieldName: {
+										Type:        schema.TypeString,
+										Description: "Deployment Resource Deployment Status Server State field (one of Creating, OK, Bad, Failed or Upgrading)",
+										Computed:    true,
+									},
+									deplStatusServerReadyFieldName: {
+										Type:        schema.TypeBool,
+										Description: "Deployment Resource Deployment Status Server Ready field",
+										Computed:    true,
+									},
+									deplStatusServerVersionFieldName: {
+										Type:        schema.TypeString,
+										Description: "Deployment Resource Deployment Status Server Version field",
+										Computed:    true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -281,19 +561,19 @@ func resourceDeployment() *schema.Resource {
 // automatically select the smallest node size if none is provided.
 func resourceDeploymentCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	client := m.(*Client)
-	if err := client.Connect(); err != nil {
-		client.log.Error().Err(err).Msg("Failed to connect to api")
+	if err := client.Connect(ctx); err != nil {
+		tflog.Error(ctx, "Failed to connect to api", map[string]interface{}{"error": err})
 		return diag.FromErr(err)
 	}
 
 	// Check if the T&C has been accepted
 	if v, ok := d.GetOk(deplTAndCAcceptedFieldName); ok {
 		if !v.(bool) {
-			client.log.Error().Str("name", deplTAndCAcceptedFieldName).Msg("Field should be set to accept Terms and Conditions")
+			tflog.Error(ctx, "Field should be set to accept Terms and Conditions", map[string]interface{}{"name": deplTAndCAcceptedFieldName})
 			return diag.Errorf("field '%s' should be set to accept Terms and Conditions", deplTAndCAcceptedFieldName)
 		}
 	} else {
-		client.log.Error().Str("name", deplTAndCAcceptedFieldName).Msg("Unable to find field, which is required to accept Terms and Conditions")
+		tflog.Error(ctx, "Unable to find field, which is required to accept Terms and Conditions", map[string]interface{}{"name": deplTAndCAcceptedFieldName})
 		return diag.Errorf("unable to find field %s", deplTAndCAcceptedFieldName)
 	}
 
@@ -302,23 +582,42 @@ func resourceDeploymentCreate(ctx context.Context, d *schema.ResourceData, m int
 	if err != nil {
 		return diag.FromErr(err)
 	}
+	// The node size selector is resolved now if its node size could not be selected at plan time
+	if selectors := d.Get(deplNodeSizeSelectorPath).([]interface{}); len(selectors) > 0 && expandedDepl.GetModel().GetNodeSizeId() == "" {
+		conf := configuration{
+			model:        expandedDepl.GetModel().GetModel(),
+			nodeCount:    int(expandedDepl.GetModel().GetNodeCount()),
+			nodeDiskSize: int(expandedDepl.GetModel().GetNodeDiskSize()),
+		}
+		nodeSize, err := selectDeploymentNodeSize(ctx, client, "", expandedDepl.GetProjectId(), expandedDepl.GetRegionId(), conf, expandedDepl.GetDiskPerformanceId(), expandNodeSizeSelector(selectors))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		expandedDepl.Model.NodeSizeId = nodeSize.GetId()
+		if err := d.Set(deplSelectedNodeSizeIDFieldName, nodeSize.GetId()); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	if backupID := d.Get(deplCloneFromBackupIDFieldName).(string); backupID != "" {
+		return resourceDeploymentCreateFromBackup(ctx, d, m, backupID, expandedDepl)
+	}
 	if expandedDepl.Version == "" {
-		defaultVersion, err := datac.GetDefaultVersion(client.ctxWithToken, &common.Empty{})
+		defaultVersion, err := datac.GetDefaultVersion(client.apiContext(ctx), &common.Empty{})
 		if err != nil {
-			client.log.Error().Err(err).Msg("Failed to get default version")
+			tflog.Error(ctx, "Failed to get default version", map[string]interface{}{"error": err})
 			return diag.FromErr(err)
 		}
 		expandedDepl.Version = defaultVersion.Version
 	}
 	if expandedDepl.Certificates.CaCertificateId == "" {
 		cryptoc := crypto.NewCryptoServiceClient(client.conn)
-		list, err := cryptoc.ListCACertificates(client.ctxWithToken, &common.ListOptions{ContextId: expandedDepl.GetProjectId()})
+		list, err := cryptoc.ListCACertificates(client.apiContext(ctx), &common.ListOptions{ContextId: expandedDepl.GetProjectId()})
 		if err != nil {
-			client.log.Error().Err(err).Msg("Failed to list CA certificates")
+			tflog.Error(ctx, "Failed to list CA certificates", map[string]interface{}{"error": err})
 			return diag.FromErr(err)
 		}
 		if len(list.GetItems()) < 1 {
-			client.log.Error().Err(err).Msg("Failed to find any CA certificates")
+			tflog.Error(ctx, "Failed to find any CA certificates", map[string]interface{}{"error": err})
 			return diag.Errorf("failed to find any CA certificates for project %s", expandedDepl.GetProjectId())
 		}
 		// Select the default certificate
@@ -335,73 +634,139 @@ func resourceDeploymentCreate(ctx context.Context, d *schema.ResourceData, m int
 		}
 
 		if expandedDepl.Certificates.CaCertificateId == "" {
-			client.log.Error().Err(err).Str("project-id", expandedDepl.ProjectId).Msg("Unable to find default certificate for project. Please select one manually.")
+			tflog.Error(ctx, "Unable to find default certificate for project. Please select one manually.", map[string]interface{}{"error": err, "project-id": expandedDepl.ProjectId})
 			return diag.Errorf("unable to find default certificate for project %s. Please select one manually", expandedDepl.GetProjectId())
 		}
 	}
 
-	if len(expandedDepl.Model.NodeSizeId) < 1 && expandedDepl.Model.Model != data.ModelFlexible {
+	if err := defaultDeploymentModel(ctx, client, expandedDepl.GetProjectId(), expandedDepl.GetRegionId(), expandedDepl.Model); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if expandedDepl.AcceptedTermsAndConditionsId, err = currentTermsAndConditionsID(ctx, client, expandedDepl.GetProjectId()); err != nil {
+		return diag.FromErr(err)
+	}
+
+	depl, err := datac.CreateDeployment(client.apiContext(ctx), expandedDepl)
+	if err != nil {
+		tflog.Error(ctx, "Failed to create deployment.", map[string]interface{}{"error": err})
+		return diag.FromErr(err)
+	}
+	d.SetId(depl.GetId())
+
+	if !expandedDepl.GetIsScheduledRootPasswordRotationEnabled() {
+		if _, err := datac.UpdateDeploymentScheduledRootPasswordRotation(client.apiContext(ctx), &data.UpdateDeploymentScheduledRootPasswordRotationRequest{
+			DeploymentId: depl.GetId(),
+			Enabled:      false,
+		}); err != nil {
+			tflog.Error(ctx, "Failed to update scheduled root password rotation setting.", map[string]interface{}{"error": err})
+			return diag.FromErr(err)
+		}
+	}
+
+	// A deployment can only be paused once it is ready
+	paused := d.Get(deplIsPausedFieldName).(bool)
+	if d.Get(deplWaitForReadyFieldName).(bool) || paused {
+		if err := waitForDeploymentReady(ctx, client, depl.GetId(), d.Timeout(schema.TimeoutCreate), 1); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	if paused {
+		if err := pauseDeployment(ctx, client, depl.GetId(), d.Get(deplWaitForReadyFieldName).(bool), d.Timeout(schema.TimeoutCreate)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceDeploymentRead(ctx, d, m)
+}
+
+// defaultDeploymentModel sets the node size, node disk size and node count of the given model spec
+// which are not configured to the defaults a deployment in the given project and region is created with.
+// Without node size the node size with the least memory is used, with its minimum disk size.
+func defaultDeploymentModel(ctx context.Context, client *Client, projectID, regionID string, spec *data.Deployment_ModelSpec) error {
+	if len(spec.NodeSizeId) < 1 && spec.Model != data.ModelFlexible {
+		datac := data.NewDataServiceClient(client.conn)
 		// Fetch node sizes
-		list, err := datac.ListNodeSizes(client.ctxWithToken, &data.NodeSizesRequest{
-			ProjectId: expandedDepl.ProjectId,
-			RegionId:  expandedDepl.RegionId,
+		list, err := datac.ListNodeSizes(client.apiContext(ctx), &data.NodeSizesRequest{
+			ProjectId: projectID,
+			RegionId:  regionID,
 		})
 		if err != nil {
-			client.log.Fatal().Err(err).Msg("Failed to fetch node size list.")
-			return diag.Errorf("failed to fetch node size list for region %s", expandedDepl.RegionId)
+			tflog.Error(ctx, "Failed to fetch node size list.", map[string]interface{}{"error": err})
+			return fmt.Errorf("failed to fetch node size list for region %s", regionID)
 		}
 		if len(list.Items) < 1 {
-			client.log.Fatal().Msg("No available node sizes found.")
-			return diag.Errorf("no available node sizes found for region %s", expandedDepl.RegionId)
+			tflog.Error(ctx, "No available node sizes found.")
+			return fmt.Errorf("no available node sizes found for region %s", regionID)
 		}
 		sort.SliceStable(list.Items, func(i, j int) bool {
 			return list.Items[i].MemorySize < list.Items[j].MemorySize
 		})
-		expandedDepl.Model.NodeSizeId = list.Items[0].Id
-		if expandedDepl.Model.NodeDiskSize == 0 {
-			expandedDepl.Model.NodeDiskSize = list.Items[0].MinDiskSize
+		spec.NodeSizeId = list.Items[0].Id
+		if spec.NodeDiskSize == 0 {
+			spec.NodeDiskSize = list.Items[0].MinDiskSize
 		}
 	}
 
-	if expandedDepl.Model.NodeCount == 0 {
-		expandedDepl.Model.NodeCount = 3
+	if spec.NodeCount == 0 {
+		spec.NodeCount = 3
 	}
-	if expandedDepl.GetModel().GetModel() == data.ModelDeveloper {
-		expandedDepl.Model.NodeCount = 1
+	if spec.GetModel() == data.ModelDeveloper {
+		spec.NodeCount = 1
 	}
+	return nil
+}
 
-	rmc := rm.NewResourceManagerServiceClient(client.conn)
-	proj, err := rmc.GetProject(client.ctxWithToken, &common.IDOptions{Id: expandedDepl.GetProjectId()})
-	if err != nil {
-		client.log.Error().Err(err).Msg("Failed to get project")
-		return diag.FromErr(err)
-	}
-	tAndC, err := rmc.GetCurrentTermsAndConditions(client.ctxWithToken, &common.IDOptions{Id: proj.GetOrganizationId()})
-	if err != nil {
-		client.log.Error().Err(err).Msg("Failed to get Terms and Conditions")
-		return diag.FromErr(err)
+// defaultDeploymentProjectDiff plans the default project of the provider as project of a new deployment
+// which does not configure one, so that the project is known to the other checks at plan time.
+func defaultDeploymentProjectDiff(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	client := m.(*Client)
+	if d.Id() != "" || client.ProjectID == "" || !isDeploymentProjectUnconfigured(d) {
+		return nil
 	}
-	client.log.Info().Str("id", tAndC.GetId()).Msg("Terms and Conditions are accepted")
-	expandedDepl.AcceptedTermsAndConditionsId = tAndC.GetId()
+	return d.SetNew(deplProjectFieldName, client.ProjectID)
+}
 
-	depl, err := datac.CreateDeployment(client.ctxWithToken, expandedDepl)
-	if err != nil {
-		client.log.Error().Err(err).Msg("Failed to create deployment.")
-		return diag.FromErr(err)
+// deploymentProjectIDDiff returns the project of a deployment at plan time, which is the default project
+// of the provider if none is configured. It returns false if the configured project is not known yet.
+func deploymentProjectIDDiff(d *schema.ResourceDiff, client *Client) (string, bool) {
+	if d.Id() == "" && isDeploymentProjectUnconfigured(d) {
+		return client.ProjectID, true
 	}
-	d.SetId(depl.GetId())
+	if !d.NewValueKnown(deplProjectFieldName) {
+		return "", false
+	}
+	if projectID := d.Get(deplProjectFieldName).(string); projectID != "" {
+		return projectID, true
+	}
+	return client.ProjectID, true
+}
 
-	if !expandedDepl.GetIsScheduledRootPasswordRotationEnabled() {
-		if _, err := datac.UpdateDeploymentScheduledRootPasswordRotation(client.ctxWithToken, &data.UpdateDeploymentScheduledRootPasswordRotationRequest{
-			DeploymentId: depl.GetId(),
-			Enabled:      false,
-		}); err != nil {
-			client.log.Error().Err(err).Msg("Failed to update scheduled root password rotation setting.")
-			return diag.FromErr(err)
-		}
+// isDeploymentProjectUnconfigured returns true if the configuration of a deployment does not set its project.
+func isDeploymentProjectUnconfigured(d *schema.ResourceDiff) bool {
+	config := d.GetRawConfig()
+	if !config.IsKnown() || config.IsNull() {
+		return false
 	}
+	return config.GetAttr(deplProjectFieldName).IsNull()
+}
 
-	return resourceDeploymentRead(ctx, d, m)
+// currentTermsAndConditionsID returns the ID of the current Terms and Conditions of the organization
+// owning the given project, which are accepted by creating a deployment in it.
+func currentTermsAndConditionsID(ctx context.Context, client *Client, projectID string) (string, error) {
+	rmc := rm.NewResourceManagerServiceClient(client.conn)
+	proj, err := rmc.GetProject(client.apiContext(ctx), &common.IDOptions{Id: projectID})
+	if err != nil {
+		tflog.Error(ctx, "Failed to get project", map[string]interface{}{"error": err})
+		return "", err
+	}
+	tAndC, err := rmc.GetCurrentTermsAndConditions(client.apiContext(ctx), &common.IDOptions{Id: proj.GetOrganizationId()})
+	if err != nil {
+		tflog.Error(ctx, "Failed to get Terms and Conditions", map[string]interface{}{"error": err})
+		return "", err
+	}
+	tflog.Info(ctx, "Terms and Conditions are accepted", map[string]interface{}{"id": tAndC.GetId()})
+	return tAndC.GetId(), nil
 }
 
 // location is a convenient wrapper around the location schema for easy parsing
@@ -482,6 +847,11 @@ func expandDeploymentResource(d *schema.ResourceData, defaultProject string) (*d
 	} else {
 		return nil, fmt.Errorf("unable to find parse field %s", deplConfigurationFieldName)
 	}
+	if conf.nodeSizeId == "" {
+		if v, ok := d.GetOk(deplSelectedNodeSizeIDFieldName); ok {
+			conf.nodeSizeId = v.(string)
+		}
+	}
 
 	if v, ok := d.GetOk(deplNotificationConfigurationFieldName); ok {
 		if notificationSetting, err = expandNotificationSettings(v.([]interface{})); err != nil {
@@ -633,28 +1003,245 @@ func expandNotificationSettings(s []interface{}) (settings *data.Deployment_Noti
 // resourceDeploymentRead retrieves deployment information from terraform stores.
 func resourceDeploymentRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	client := m.(*Client)
-	if err := client.Connect(); err != nil {
-		client.log.Error().Err(err).Msg("Failed to connect to api")
+	if err := client.Connect(ctx); err != nil {
+		tflog.Error(ctx, "Failed to connect to api", map[string]interface{}{"error": err})
 		return diag.FromErr(err)
 	}
 
 	datac := data.NewDataServiceClient(client.conn)
-	depl, err := datac.GetDeployment(client.ctxWithToken, &common.IDOptions{Id: d.Id()})
-	if err != nil {
-		client.log.Error().Err(err).Msg("Failed to find deployment")
+	depl, err := datac.GetDeployment(client.apiContext(ctx), &common.IDOptions{Id: d.Id()})
+	if isRemoved(depl, err) {
+		tflog.Warn(ctx, "Deployment has been deleted, removing it from state", map[string]interface{}{"deployment-id": d.Id()})
 		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		tflog.Error(ctx, "Failed to find deployment", map[string]interface{}{"error": err, "deployment-id": d.Id()})
 		return diag.FromErr(err)
 	}
 
+	// Every read of the root credentials is audited, so they are only read when they are not known yet
+	// or the root password has been rotated since
+	storedPassword := d.Get(deplRootPasswordFieldName).(string)
+	readCredentials := storedPassword == "" || d.Get(deplLastRootPasswordRotatedAtFieldName).(string) != lastRootPasswordRotatedAt(depl)
+	if !readCredentials {
+		client.registerSecret(storedPassword)
+	}
+
 	for k, v := range flattenDeployment(depl) {
 		if err := d.Set(k, v); err != nil {
-			d.SetId("")
+			return diag.FromErr(err)
+		}
+	}
+	if err := d.Set(deplStatusFieldName, flattenDeploymentStatus(depl)); err != nil {
+		return diag.FromErr(err)
+	}
+	connection, err := readDeploymentConnection(ctx, client, depl, readCredentials)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	for k, v := range connection {
+		if err := d.Set(k, v); err != nil {
 			return diag.FromErr(err)
 		}
 	}
 	return nil
 }
 
+// resourceDeploymentImport imports a deployment by its ID.
+// An existing deployment has accepted the terms and conditions, and is waited for by default,
+// which are the only fields that are not read from the API.
+func resourceDeploymentImport(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	if err := d.Set(deplTAndCAcceptedFieldName, true); err != nil {
+		return nil, err
+	}
+	if err := d.Set(deplWaitForReadyFieldName, true); err != nil {
+		return nil, err
+	}
+	return []*schema.ResourceData{d}, nil
+}
+
+// readDeploymentConnection fetches the CA certificate and, if readCredentials is set, the root credentials
+// of a deployment and returns them together with its endpoints as a map for terraform schema.
+// The root credentials are only available once the deployment is bootstrapped, and are
+// left empty when the API key is not permitted to read them.
+func readDeploymentConnection(ctx context.Context, client *Client, depl *data.Deployment, readCredentials bool) (map[string]interface{}, error) {
+	result := flattenDeploymentEndpoints(depl)
+
+	caCertificatePEM := ""
+	if id := depl.GetCertificates().GetCaCertificateId(); id != "" {
+		cryptoc := crypto.NewCryptoServiceClient(client.conn)
+		cert, err := cryptoc.GetCACertificate(client.apiContext(ctx), &common.IDOptions{Id: id})
+		if err != nil {
+			tflog.Error(ctx, "Failed to get CA certificate", map[string]interface{}{"error": err, "ca-certificate-id": id})
+			return nil, err
+		}
+		caCertificatePEM = cert.GetCertificatePem()
+	}
+	result[deplCACertificatePEMFieldName] = caCertificatePEM
+	if !readCredentials {
+		return result, nil
+	}
+
+	username, password := "", ""
+	if depl.GetStatus().GetBootstrapped() {
+		datac := data.NewDataServiceClient(client.conn)
+		creds, err := datac.GetDeploymentCredentials(client.apiContext(ctx), &data.DeploymentCredentialsRequest{
+			DeploymentId: depl.GetId(),
+			Reason:       deplCredentialsReason,
+		})
+		if common.IsPermissionDenied(err) {
+			tflog.Warn(ctx, "Not permitted to read deployment credentials, leaving root credentials empty", map[string]interface{}{"deployment-id": depl.GetId()})
+		} else if err != nil {
+			tflog.Error(ctx, "Failed to get deployment credentials", map[string]interface{}{"error": err, "deployment-id": depl.GetId()})
+			return nil, err
+		} else {
+			client.registerSecret(creds.GetPassword())
+			username, password = creds.GetUsername(), creds.GetPassword()
+		}
+	}
+	result[deplRootUsernameFieldName] = username
+	result[deplRootPasswordFieldName] = password
+	return result, nil
+}
+
+// lastRootPasswordRotatedAt returns the time of the last rotation of the root password of a deployment,
+// or an empty string if it is not known.
+func lastRootPasswordRotatedAt(depl *data.Deployment) string {
+	if depl.GetLastRootPasswordRotatedAt() == nil {
+		return ""
+	}
+	return depl.GetLastRootPasswordRotatedAt().AsTime().Format(time.RFC3339Nano)
+}
+
+// flattenDeploymentEndpoints takes the endpoints of a deployment and creates a map for terraform schema.
+func flattenDeploymentEndpoints(depl *data.Deployment) map[string]interface{} {
+	status := depl.GetStatus()
+	return map[string]interface{}{
+		deplEndpointFieldName:                  status.GetEndpoint(),
+		deplEndpointDefaultFieldName:           status.GetEndpointDefault(),
+		deplEndpointSelfSignedFieldName:        status.GetEndpointSelfSigned(),
+		deplPrivateEndpointFieldName:           status.GetEndpointPrivateEndpoint(),
+		deplPrivateEndpointDefaultFieldName:    status.GetEndpointPrivateEndpointDefault(),
+		deplPrivateEndpointSelfSignedFieldName: status.GetEndpointPrivateEndpointSelfSigned(),
+	}
+}
+
+// flattenDeploymentStatus takes the status part of a deployment and creates a sub map for terraform schema.
+func flattenDeploymentStatus(depl *data.Deployment) []interface{} {
+	status := depl.GetStatus()
+	servers := make([]interface{}, 0, len(status.GetServers()))
+	for _, server := range status.GetServers() {
+		servers = append(servers, map[string]interface{}{
+			deplStatusServerIDFieldName:      server.GetId(),
+			deplStatusServerTypeFieldName:    server.GetType(),
+			deplStatusServerStateFieldName:   serverState(server),
+			deplStatusServerReadyFieldName:   server.GetReady(),
+			deplStatusServerVersionFieldName: server.GetVersion(),
+		})
+	}
+	return []interface{}{
+		map[string]interface{}{
+			deplStatusPhaseFieldName:        deploymentPhase(depl),
+			deplStatusDescriptionFieldName:  status.GetDescription(),
+			deplStatusReadyFieldName:        status.GetReady(),
+			deplStatusBootstrappedFieldName: status.GetBootstrapped(),
+			deplStatusUpgradingFieldName:    status.GetUpgrading(),
+			deplStatusCreatedAtFieldName:    depl.GetCreatedAt().AsTime().Format(time.RFC3339Nano),
+			deplStatusServersFieldName:      servers,
+		},
+	}
+}
+
+// deploymentPhase summarizes the status of a deployment in a single phase.
+func deploymentPhase(depl *data.Deployment) string {
+	status := depl.GetStatus()
+	switch {
+	case depl.GetIsDeleted():
+		return deplPhaseDeleting
+	case depl.GetIsPaused():
+		for _, server := range status.GetServers() {
+			if server.GetReady() {
+				return deplPhasePausing
+			}
+		}
+		return deplPhasePaused
+	case !status.GetCreated():
+		return deplPhaseCreating
+	case status.GetUpgrading():
+		return deplPhaseUpgrading
+	case !status.GetBootstrapped():
+		return deplPhaseBootstrapping
+	case status.GetBackupRestoreStatus().GetRestoring():
+		return deplPhaseRestoring
+	case !status.GetReady():
+		return deplPhaseNotReady
+	case !status.GetIsUpToDate():
+		return deplPhaseUpdating
+	default:
+		return deplPhaseReady
+	}
+}
+
+// serverState returns the state a server of a deployment is in.
+func serverState(server *data.Deployment_ServerStatus) string {
+	switch {
+	case server.GetFailed():
+		return "Failed"
+	case server.GetCreating():
+		return "Creating"
+	case server.GetUpgrading():
+		return "Upgrading"
+	case server.GetBad():
+		return "Bad"
+	case server.GetOk():
+		return "OK"
+	default:
+		return ""
+	}
+}
+
+// waitForDeploymentReady polls a deployment until it is ready and all changes are rolled out.
+// The deployment has to be ready in the given number of consecutive polls, so that a status
+// which does not yet reflect a change just made is not mistaken for the result of that change.
+func waitForDeploymentReady(ctx context.Context, client *Client, deploymentID string, timeout time.Duration, occurrences int) error {
+	pending := []string{deplPhaseCreating, deplPhaseBootstrapping, deplPhaseUpgrading, deplPhaseRestoring, deplPhaseUpdating, deplPhaseNotReady}
+	return waitForDeploymentPhase(ctx, client, deploymentID, pending, deplPhaseReady, timeout, occurrences)
+}
+
+// waitForDeploymentPaused polls a deployment until all its servers are stopped after it has been paused.
+func waitForDeploymentPaused(ctx context.Context, client *Client, deploymentID string, timeout time.Duration) error {
+	return waitForDeploymentPhase(ctx, client, deploymentID, []string{deplPhasePausing}, deplPhasePaused, timeout, 1)
+}
+
+// waitForDeploymentPhase polls a deployment until it reaches the target phase,
+// failing if it is in any phase other than the pending ones.
+func waitForDeploymentPhase(ctx context.Context, client *Client, deploymentID string, pending []string, target string, timeout time.Duration, occurrences int) error {
+	datac := data.NewDataServiceClient(client.conn)
+	tflog.Info(ctx, "Waiting for deployment to reach phase", map[string]interface{}{"deployment-id": deploymentID, "phase": target})
+	waiter := &resource.StateChangeConf{
+		Pending: pending,
+		Target:  []string{target},
+		Refresh: func() (interface{}, string, error) {
+			depl, err := datac.GetDeployment(client.apiContext(ctx), &common.IDOptions{Id: deploymentID})
+			if err != nil {
+				return nil, "", err
+			}
+			phase := deploymentPhase(depl)
+			tflog.Debug(ctx, "Polled deployment status", map[string]interface{}{"deployment-id": deploymentID, "phase": phase, "description": depl.GetStatus().GetDescription()})
+			return depl, phase, nil
+		},
+		Timeout:                   timeout,
+		PollInterval:              deploymentPollInterval,
+		ContinuousTargetOccurence: occurrences,
+	}
+	if _, err := waiter.WaitForStateContext(ctx); err != nil {
+		tflog.Error(ctx, "Failed to wait for deployment to reach phase", map[string]interface{}{"error": err, "deployment-id": deploymentID, "phase": target})
+		return fmt.Errorf("failed to wait for deployment %s to become %s: %w", deploymentID, strings.ToLower(target), err)
+	}
+	return nil
+}
+
 // flattenDeployment creates a map from a deployment for easy storage on terraform.
 func flattenDeployment(depl *data.Deployment) map[string]interface{} {
 	conf := flattenConfigurationData(depl)
@@ -676,6 +1263,13 @@ func flattenDeployment(depl *data.Deployment) map[string]interface{} {
 		deplLockedFieldName:                               depl.GetLocked(),
 		deplIsPlatformAuthEnabled:                         depl.GetIsPlatformAuthenticationEnabled(),
 		deplDropVSTSupportFieldName:                       depl.GetDropVstSupport(),
+		deplIsPausedFieldName:                             depl.GetIsPaused(),
+	}
+	if depl.GetLastPausedAt() != nil {
+		result[deplLastPausedAtFieldName] = depl.GetLastPausedAt().AsTime().Format(time.RFC3339Nano)
+	}
+	if rotatedAt := lastRootPasswordRotatedAt(depl); rotatedAt != "" {
+		result[deplLastRootPasswordRotatedAtFieldName] = rotatedAt
 	}
 	if notificationSetting != nil {
 		result[deplNotificationConfigurationFieldName] = notificationSetting
@@ -746,18 +1340,22 @@ func flattenNotificationSettings(depl *data.Deployment) []interface{} {
 // resourceDeploymentUpdate checks fields for differences and updates a deployment if necessary.
 func resourceDeploymentUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	client := m.(*Client)
-	if err := client.Connect(); err != nil {
-		client.log.Error().Err(err).Msg("Failed to connect to api")
+	if err := client.Connect(ctx); err != nil {
+		tflog.Error(ctx, "Failed to connect to api", map[string]interface{}{"error": err})
 		return diag.FromErr(err)
 	}
 	datac := data.NewDataServiceClient(client.conn)
-	depl, err := datac.GetDeployment(client.ctxWithToken, &common.IDOptions{Id: d.Id()})
+	depl, err := datac.GetDeployment(client.apiContext(ctx), &common.IDOptions{Id: d.Id()})
+	if isRemoved(depl, err) {
+		tflog.Warn(ctx, "Deployment has been deleted, it is removed from state by the next refresh", map[string]interface{}{"deployment-id": d.Id()})
+		return diag.Errorf("deployment %s has been deleted", d.Id())
+	}
 	if err != nil {
-		client.log.Error().Err(err).Msg("Failed to find deployment")
-		d.SetId("")
+		tflog.Error(ctx, "Failed to find deployment", map[string]interface{}{"error": err, "deployment-id": d.Id()})
 		return diag.FromErr(err)
 	}
 
+	var diags diag.Diagnostics
 	if d.HasChange(deplNameFieldName) {
 		depl.Name = d.Get(deplNameFieldName).(string)
 	}
@@ -770,6 +1368,23 @@ func resourceDeploymentUpdate(ctx context.Context, d *schema.ResourceData, m int
 			return diag.FromErr(err)
 		}
 		if ver.dbVersion != "" {
+			if current := depl.GetVersion(); current != "" && current != ver.dbVersion {
+				available, upgrades, err := fetchVersions(ctx, client, depl.GetProjectId(), current)
+				if err != nil {
+					return diag.FromErr(err)
+				}
+				_, warning, err := checkVersionUpgrade(current, ver.dbVersion, available, upgrades)
+				if err != nil {
+					return diag.FromErr(err)
+				}
+				if warning != "" {
+					diags = append(diags, diag.Diagnostic{
+						Severity:      diag.Warning,
+						Summary:       warning,
+						AttributePath: cty.GetAttrPath(deplVersionFieldName).IndexInt(0).GetAttr(deplVersionDbVersionFieldName),
+					})
+				}
+			}
 			depl.Version = ver.dbVersion
 		}
 	}
@@ -805,6 +1420,11 @@ func resourceDeploymentUpdate(ctx context.Context, d *schema.ResourceData, m int
 			depl.DiskAutoSizeSettings.MaximumNodeDiskSize = int32(conf.maximumNodeDiskSize)
 		}
 	}
+	if d.HasChange(deplSelectedNodeSizeIDFieldName) {
+		if id := d.Get(deplSelectedNodeSizeIDFieldName).(string); id != "" {
+			depl.Model.NodeSizeId = id
+		}
+	}
 	// if we have change on NotificationSettings apply it
 	if d.HasChange(deplNotificationConfigurationFieldName) {
 		settings, err := expandNotificationSettings(d.Get(deplNotificationConfigurationFieldName).([]interface{}))
@@ -829,8 +1449,20 @@ func resourceDeploymentUpdate(ctx context.Context, d *schema.ResourceData, m int
 		return diag.FromErr(errors.New("deployment profile id cannot be changed"))
 	}
 
-	if res, err := datac.UpdateDeployment(client.ctxWithToken, depl); err != nil {
-		client.log.Error().Err(err).Msg("Failed to update deployment")
+	// A deployment is resumed before, and paused after, the other changes are applied
+	waitForReady := d.Get(deplWaitForReadyFieldName).(bool)
+	paused := d.Get(deplIsPausedFieldName).(bool)
+	resumed := d.HasChange(deplIsPausedFieldName) && !paused
+	if resumed {
+		if _, err := datac.ResumeDeployment(client.apiContext(ctx), &common.IDOptions{Id: depl.GetId()}); err != nil {
+			tflog.Error(ctx, "Failed to resume deployment", map[string]interface{}{"error": err, "deployment-id": depl.GetId()})
+			return diag.FromErr(err)
+		}
+		depl.IsPaused = false
+	}
+
+	if res, err := datac.UpdateDeployment(client.apiContext(ctx), depl); err != nil {
+		tflog.Error(ctx, "Failed to update deployment", map[string]interface{}{"error": err})
 		return diag.FromErr(err)
 	} else {
 		d.SetId(res.GetId())
@@ -838,29 +1470,55 @@ func resourceDeploymentUpdate(ctx context.Context, d *schema.ResourceData, m int
 
 	if d.HasChange(deplDisableScheduledRootPasswordRotationFieldName) {
 		disabled := d.Get(deplDisableScheduledRootPasswordRotationFieldName).(bool)
-		if _, err := datac.UpdateDeploymentScheduledRootPasswordRotation(client.ctxWithToken, &data.UpdateDeploymentScheduledRootPasswordRotationRequest{
+		if _, err := datac.UpdateDeploymentScheduledRootPasswordRotation(client.apiContext(ctx), &data.UpdateDeploymentScheduledRootPasswordRotationRequest{
 			DeploymentId: depl.GetId(),
 			Enabled:      !disabled,
 		}); err != nil {
-			client.log.Error().Err(err).Msg("Failed to update scheduled root password rotation setting")
+			tflog.Error(ctx, "Failed to update scheduled root password rotation setting", map[string]interface{}{"error": err})
 			return diag.FromErr(err)
 		}
 		depl.IsScheduledRootPasswordRotationEnabled = !disabled
 	}
 
-	return resourceDeploymentRead(ctx, d, m)
+	// Only these changes are rolled out to the servers of the deployment
+	rolledOut := d.HasChanges(deplVersionFieldName, deplConfigurationFieldName, deplSelectedNodeSizeIDFieldName, deplDiskPerformanceFieldName, deplSecurityFieldName)
+	if waitForReady && (resumed || rolledOut && !depl.GetIsPaused()) {
+		if err := waitForDeploymentReady(ctx, client, depl.GetId(), d.Timeout(schema.TimeoutUpdate), 2); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	if d.HasChange(deplIsPausedFieldName) && paused {
+		if err := pauseDeployment(ctx, client, depl.GetId(), waitForReady, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return append(diags, resourceDeploymentRead(ctx, d, m)...)
+}
+
+// pauseDeployment pauses a deployment and optionally waits until all its servers are stopped.
+func pauseDeployment(ctx context.Context, client *Client, deploymentID string, wait bool, timeout time.Duration) error {
+	datac := data.NewDataServiceClient(client.conn)
+	if _, err := datac.PauseDeployment(client.apiContext(ctx), &common.IDOptions{Id: deploymentID}); err != nil {
+		tflog.Error(ctx, "Failed to pause deployment", map[string]interface{}{"error": err, "deployment-id": deploymentID})
+		return err
+	}
+	if wait {
+		return waitForDeploymentPaused(ctx, client, deploymentID, timeout)
+	}
+	return nil
 }
 
 func resourceDeploymentDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	client := m.(*Client)
-	if err := client.Connect(); err != nil {
-		client.log.Error().Err(err).Msg("Failed to connect to api")
+	if err := client.Connect(ctx); err != nil {
+		tflog.Error(ctx, "Failed to connect to api", map[string]interface{}{"error": err})
 		return diag.FromErr(err)
 	}
 
 	datac := data.NewDataServiceClient(client.conn)
-	if _, err := datac.DeleteDeployment(client.ctxWithToken, &common.IDOptions{Id: d.Id()}); err != nil {
-		client.log.Error().Err(err).Str("deployment-id", d.Id()).Msg("Failed to delete deployment")
+	if _, err := datac.DeleteDeployment(client.apiContext(ctx), &common.IDOptions{Id: d.Id()}); err != nil {
+		tflog.Error(ctx, "Failed to delete deployment", map[string]interface{}{"error": err, "deployment-id": d.Id()})
 		return diag.FromErr(err)
 	}
 	d.SetId("") // called automatically, but added to be explicit
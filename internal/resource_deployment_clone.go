@@ -0,0 +1,272 @@
+//
+// DISCLAIMER
+//
+// Copyright 2024 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	backup "github.com/arangodb-managed/apis/backup/v1"
+	common "github.com/arangodb-managed/apis/common/v1"
+	data "github.com/arangodb-managed/apis/data/v1"
+	platform "github.com/arangodb-managed/apis/platform/v1"
+	replication "github.com/arangodb-managed/apis/replication/v1"
+)
+
+const (
+	// backupRestoreStatusFailed is the status of a failed restore of a backup into a deployment
+	backupRestoreStatusFailed = "Failed"
+)
+
+// resourceDeploymentCreateFromBackup creates a deployment by cloning it from a backup.
+// The clone starts with the configuration of the deployment the backup was taken from, and its data is
+// restored once it is bootstrapped, which is always waited for. The configured settings are applied afterwards.
+func resourceDeploymentCreateFromBackup(ctx context.Context, d *schema.ResourceData, m interface{}, backupID string, expandedDepl *data.Deployment) diag.Diagnostics {
+	client := m.(*Client)
+	tAndCID, err := currentTermsAndConditionsID(ctx, client, expandedDepl.GetProjectId())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	replc := replication.NewReplicationServiceClient(client.conn)
+	depl, err := replc.CloneDeploymentFromBackup(client.apiContext(ctx), &replication.CloneDeploymentFromBackupRequest{
+		BackupId:                     backupID,
+		RegionId:                     expandedDepl.GetRegionId(),
+		AcceptedTermsAndConditionsId: tAndCID,
+		ProjectId:                    expandedDepl.GetProjectId(),
+	})
+	if err != nil {
+		tflog.Error(ctx, "Failed to clone deployment from backup", map[string]interface{}{"error": err, "backup-id": backupID})
+		return diag.FromErr(err)
+	}
+	d.SetId(depl.GetId())
+
+	// The restore of the backup may only start after the clone is ready, so it has to be ready twice in a row
+	timeout := d.Timeout(schema.TimeoutCreate)
+	if err := waitForDeploymentReady(ctx, client, depl.GetId(), timeout, 2); err != nil {
+		return diag.FromErr(err)
+	}
+	datac := data.NewDataServiceClient(client.conn)
+	depl, err = datac.GetDeployment(client.apiContext(ctx), &common.IDOptions{Id: depl.GetId()})
+	if err != nil {
+		tflog.Error(ctx, "Failed to find deployment", map[string]interface{}{"error": err, "deployment-id": d.Id()})
+		return diag.FromErr(err)
+	}
+	if status := depl.GetStatus().GetBackupRestoreStatus(); status.GetStatus() == backupRestoreStatusFailed {
+		tflog.Error(ctx, "Failed to restore backup", map[string]interface{}{"reason": status.GetFailureReason(), "backup-id": backupID, "deployment-id": depl.GetId()})
+		return diag.Errorf("failed to restore backup %s into deployment %s: %s", backupID, depl.GetId(), status.GetFailureReason())
+	}
+
+	rolledOut := mergeClonedDeployment(depl, expandedDepl)
+	if _, err := datac.UpdateDeployment(client.apiContext(ctx), depl); err != nil {
+		tflog.Error(ctx, "Failed to update deployment", map[string]interface{}{"error": err, "deployment-id": depl.GetId()})
+		return diag.FromErr(err)
+	}
+	if enabled := expandedDepl.GetIsScheduledRootPasswordRotationEnabled(); enabled != depl.GetIsScheduledRootPasswordRotationEnabled() {
+		if _, err := datac.UpdateDeploymentScheduledRootPasswordRotation(client.apiContext(ctx), &data.UpdateDeploymentScheduledRootPasswordRotationRequest{
+			DeploymentId: depl.GetId(),
+			Enabled:      enabled,
+		}); err != nil {
+			tflog.Error(ctx, "Failed to update scheduled root password rotation setting", map[string]interface{}{"error": err})
+			return diag.FromErr(err)
+		}
+	}
+
+	paused := d.Get(deplIsPausedFieldName).(bool)
+	if rolledOut && (d.Get(deplWaitForReadyFieldName).(bool) || paused) {
+		if err := waitForDeploymentReady(ctx, client, depl.GetId(), timeout, 2); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	if paused {
+		if err := pauseDeployment(ctx, client, depl.GetId(), d.Get(deplWaitForReadyFieldName).(bool), timeout); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceDeploymentRead(ctx, d, m)
+}
+
+// mergeClonedDeployment applies the configured settings of a deployment to its clone.
+// Settings of the configuration which are not set are kept as cloned, like on an update.
+// It returns true if a change has to be rolled out to the servers of the clone.
+func mergeClonedDeployment(clone, expanded *data.Deployment) bool {
+	rolledOut := false
+	clone.Name = expanded.GetName()
+	clone.Description = expanded.GetDescription()
+	clone.NotificationSettings = expanded.GetNotificationSettings()
+	clone.Locked = expanded.GetLocked()
+	clone.DropVstSupport = expanded.GetDropVstSupport()
+	if expanded.GetIsPlatformAuthenticationEnabled() != clone.GetIsPlatformAuthenticationEnabled() {
+		clone.IsPlatformAuthenticationEnabled = expanded.GetIsPlatformAuthenticationEnabled()
+		rolledOut = true
+	}
+	if expanded.GetDisableFoxxAuthentication() != clone.GetDisableFoxxAuthentication() {
+		clone.DisableFoxxAuthentication = expanded.GetDisableFoxxAuthentication()
+		rolledOut = true
+	}
+
+	if id := expanded.GetCertificates().GetCaCertificateId(); id != "" && id != clone.GetCertificates().GetCaCertificateId() {
+		if clone.Certificates == nil {
+			clone.Certificates = &data.Deployment_CertificateSpec{}
+		}
+		clone.Certificates.CaCertificateId = id
+		rolledOut = true
+	}
+	if id := expanded.GetIpallowlistId(); id != clone.GetIpallowlistId() {
+		clone.IpallowlistId = id
+		rolledOut = true
+	}
+	if id := expanded.GetDiskPerformanceId(); id != "" && id != clone.GetDiskPerformanceId() {
+		clone.DiskPerformanceId = id
+		rolledOut = true
+	}
+
+	if clone.Model == nil {
+		clone.Model = &data.Deployment_ModelSpec{}
+	}
+	model := expanded.GetModel()
+	if model.GetModel() != "" && model.GetModel() != clone.Model.GetModel() {
+		clone.Model.Model = model.GetModel()
+		rolledOut = true
+	}
+	if model.GetNodeSizeId() != "" && model.GetNodeSizeId() != clone.Model.GetNodeSizeId() {
+		clone.Model.NodeSizeId = model.GetNodeSizeId()
+		rolledOut = true
+	}
+	if model.GetNodeCount() != 0 && model.GetNodeCount() != clone.Model.GetNodeCount() {
+		clone.Model.NodeCount = model.GetNodeCount()
+		rolledOut = true
+	}
+	if model.GetNodeDiskSize() != 0 && model.GetNodeDiskSize() != clone.Model.GetNodeDiskSize() {
+		clone.Model.NodeDiskSize = model.GetNodeDiskSize()
+		rolledOut = true
+	}
+	if expanded.GetDiskAutoSizeSettings() != nil {
+		clone.DiskAutoSizeSettings = expanded.GetDiskAutoSizeSettings()
+	}
+	return rolledOut
+}
+
+// validateDeploymentCloneDiff checks that the backup a new deployment is cloned from has been uploaded,
+// and that its region and version fit the configuration of the deployment, so that a clone which
+// cannot be created is rejected at plan time instead of during apply.
+// A deployment profile is only applied when a deployment is created from scratch, so it cannot be
+// combined with a clone.
+// Validation is skipped while any of the involved values is not known yet.
+func validateDeploymentCloneDiff(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	if d.Id() != "" {
+		return nil
+	}
+	if d.Get(deplCloneFromBackupIDFieldName).(string) != "" && d.Get(deplDeploymentProfileIDFieldName).(string) != "" {
+		return fmt.Errorf("%s: a deployment cloned from a backup cannot use a deployment profile", deplDeploymentProfileIDFieldName)
+	}
+	for _, key := range []string{deplCloneFromBackupIDFieldName, deplRegionPath, deplDbVersionPath} {
+		if !d.NewValueKnown(key) {
+			return nil
+		}
+	}
+	backupID := d.Get(deplCloneFromBackupIDFieldName).(string)
+	if backupID == "" {
+		return nil
+	}
+
+	client := m.(*Client)
+	if err := client.Connect(ctx); err != nil {
+		tflog.Error(ctx, "Failed to connect to api", map[string]interface{}{"error": err})
+		return err
+	}
+
+	backupc := backup.NewBackupServiceClient(client.conn)
+	b, err := backupc.GetBackup(client.apiContext(ctx), &common.IDOptions{Id: backupID})
+	if isRemoved(b, err) {
+		return fmt.Errorf("%s: unknown backup %q", deplCloneFromBackupIDFieldName, backupID)
+	}
+	if err != nil {
+		tflog.Error(ctx, "Failed to get backup", map[string]interface{}{"error": err, "backup-id": backupID})
+		return err
+	}
+
+	// A backup without region is stored in the region of the deployment it was taken from
+	backupRegionID := b.GetRegionId()
+	if backupRegionID == "" {
+		datac := data.NewDataServiceClient(client.conn)
+		depl, err := datac.GetDeployment(client.apiContext(ctx), &common.IDOptions{Id: b.GetDeploymentId()})
+		if err != nil && !common.IsNotFound(err) {
+			tflog.Error(ctx, "Failed to find deployment", map[string]interface{}{"error": err, "deployment-id": b.GetDeploymentId()})
+			return err
+		}
+		backupRegionID = depl.GetRegionId()
+	}
+	region, err := getRegion(ctx, client, d.Get(deplRegionPath).(string))
+	if err != nil {
+		return err
+	}
+	backupRegion, err := getRegion(ctx, client, backupRegionID)
+	if err != nil {
+		return err
+	}
+	return checkCloneFromBackup(b, d.Get(deplDbVersionPath).(string), region, backupRegion)
+}
+
+// getRegion returns the region with the given ID, or nil if it is not known.
+func getRegion(ctx context.Context, client *Client, regionID string) (*platform.Region, error) {
+	if regionID == "" {
+		return nil, nil
+	}
+	platformc := platform.NewPlatformServiceClient(client.conn)
+	region, err := platformc.GetRegion(client.apiContext(ctx), &common.IDOptions{Id: regionID})
+	if common.IsNotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		tflog.Error(ctx, "Failed to get region", map[string]interface{}{"error": err, "region-id": regionID})
+		return nil, err
+	}
+	return region, nil
+}
+
+// checkCloneFromBackup checks that a deployment with the given version can be cloned from a backup into the given region.
+// A clone uses the cloud provider of the backup region and the version of the backup. Regions which are not known are not checked.
+// All violations are returned, each prefixed with the path of the offending attribute.
+func checkCloneFromBackup(b *backup.Backup, dbVersion string, region, backupRegion *platform.Region) error {
+	var errs []error
+	if !b.GetStatus().GetUploadStatus().GetUploaded() {
+		errs = append(errs, fmt.Errorf("%s: backup %q has not been uploaded", deplCloneFromBackupIDFieldName, b.GetId()))
+	}
+	if region != nil && backupRegion != nil && region.GetProviderId() != backupRegion.GetProviderId() {
+		errs = append(errs, fmt.Errorf("%s: region %q of provider %q does not fit backup %q, which is stored in region %q of provider %q",
+			deplRegionPath, region.GetId(), region.GetProviderId(), b.GetId(), backupRegion.GetId(), backupRegion.GetProviderId()))
+	}
+	backupVersion := b.GetDeploymentInfo().GetVersion()
+	if backupVersion == "" {
+		backupVersion = b.GetStatus().GetVersion()
+	}
+	if dbVersion != "" && backupVersion != "" && dbVersion != backupVersion {
+		errs = append(errs, fmt.Errorf("%s: version %q does not fit backup %q, which has version %q", deplDbVersionPath, dbVersion, b.GetId(), backupVersion))
+	}
+	return errors.Join(errs...)
+}
@@ -0,0 +1,126 @@
+//
+// DISCLAIMER
+//
+// Copyright 2024 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	backup "github.com/arangodb-managed/apis/backup/v1"
+	data "github.com/arangodb-managed/apis/data/v1"
+	platform "github.com/arangodb-managed/apis/platform/v1"
+)
+
+// TestCheckCloneFromBackup tests the plan time validation of the backup a deployment is cloned from.
+func TestCheckCloneFromBackup(t *testing.T) {
+	uploaded := &backup.Backup{
+		Id:             "backup-id",
+		DeploymentInfo: &backup.Backup_DeploymentInfo{Version: "3.11.4"},
+		Status:         &backup.Backup_Status{UploadStatus: &backup.Backup_UploadStatus{Uploaded: true}},
+	}
+	gcp := &platform.Region{Id: "gcp-europe-west4", ProviderId: "gcp"}
+	aws := &platform.Region{Id: "aws-us-east-2", ProviderId: "aws"}
+
+	t.Run("fitting backup", func(tt *testing.T) {
+		assert.NoError(tt, checkCloneFromBackup(uploaded, "3.11.4", gcp, &platform.Region{Id: "gcp-us-central1", ProviderId: "gcp"}))
+	})
+
+	t.Run("unknown values are not validated", func(tt *testing.T) {
+		assert.NoError(tt, checkCloneFromBackup(uploaded, "", nil, aws))
+	})
+
+	t.Run("not uploaded", func(tt *testing.T) {
+		err := checkCloneFromBackup(&backup.Backup{Id: "backup-id"}, "", gcp, gcp)
+		require.Error(tt, err)
+		assert.Contains(tt, err.Error(), `clone_from_backup_id: backup "backup-id" has not been uploaded`)
+	})
+
+	t.Run("other provider", func(tt *testing.T) {
+		err := checkCloneFromBackup(uploaded, "", aws, gcp)
+		require.Error(tt, err)
+		assert.Contains(tt, err.Error(), `location.0.region: region "aws-us-east-2" of provider "aws" does not fit backup "backup-id", which is stored in region "gcp-europe-west4" of provider "gcp"`)
+	})
+
+	t.Run("other version", func(tt *testing.T) {
+		err := checkCloneFromBackup(uploaded, "3.12.0", gcp, gcp)
+		require.Error(tt, err)
+		assert.Contains(tt, err.Error(), `version.0.db_version: version "3.12.0" does not fit backup "backup-id", which has version "3.11.4"`)
+	})
+}
+
+// TestMergeClonedDeployment tests that the configured settings are applied to a cloned deployment.
+func TestMergeClonedDeployment(t *testing.T) {
+	newClone := func() *data.Deployment {
+		return &data.Deployment{
+			Name:          "production",
+			IpallowlistId: "production-allowlist",
+			Certificates:  &data.Deployment_CertificateSpec{CaCertificateId: "production-ca"},
+			Model:         &data.Deployment_ModelSpec{Model: data.ModelOneShard, NodeSizeId: "c4-a8", NodeCount: 3, NodeDiskSize: 32},
+			Locked:        true,
+		}
+	}
+
+	t.Run("settings only", func(tt *testing.T) {
+		clone := newClone()
+		expanded := &data.Deployment{
+			Name:          "staging",
+			Description:   "Seeded from production",
+			IpallowlistId: "production-allowlist",
+			Certificates:  &data.Deployment_CertificateSpec{},
+			Model:         &data.Deployment_ModelSpec{Model: data.ModelOneShard},
+		}
+		assert.False(tt, mergeClonedDeployment(clone, expanded))
+		assert.Equal(tt, "staging", clone.GetName())
+		assert.Equal(tt, "Seeded from production", clone.GetDescription())
+		assert.False(tt, clone.GetLocked())
+		assert.Equal(tt, "production-ca", clone.GetCertificates().GetCaCertificateId())
+		assert.Equal(tt, &data.Deployment_ModelSpec{Model: data.ModelOneShard, NodeSizeId: "c4-a8", NodeCount: 3, NodeDiskSize: 32}, clone.GetModel())
+	})
+
+	t.Run("changes to roll out", func(tt *testing.T) {
+		clone := newClone()
+		expanded := &data.Deployment{
+			Name:         "staging",
+			Certificates: &data.Deployment_CertificateSpec{CaCertificateId: "staging-ca"},
+			Model:        &data.Deployment_ModelSpec{Model: data.ModelOneShard, NodeSizeId: "c4-a4", NodeDiskSize: 16},
+		}
+		assert.True(tt, mergeClonedDeployment(clone, expanded))
+		assert.Equal(tt, "", clone.GetIpallowlistId())
+		assert.Equal(tt, "staging-ca", clone.GetCertificates().GetCaCertificateId())
+		assert.Equal(tt, &data.Deployment_ModelSpec{Model: data.ModelOneShard, NodeSizeId: "c4-a4", NodeCount: 3, NodeDiskSize: 16}, clone.GetModel())
+	})
+
+	t.Run("authentication settings", func(tt *testing.T) {
+		clone := newClone()
+		expanded := &data.Deployment{
+			Name:                            "staging",
+			IpallowlistId:                   "production-allowlist",
+			Model:                           &data.Deployment_ModelSpec{Model: data.ModelOneShard},
+			IsPlatformAuthenticationEnabled: true,
+			DisableFoxxAuthentication:       true,
+		}
+		assert.True(tt, mergeClonedDeployment(clone, expanded))
+		assert.True(tt, clone.GetIsPlatformAuthenticationEnabled())
+		assert.True(tt, clone.GetDisableFoxxAuthentication())
+	})
+}
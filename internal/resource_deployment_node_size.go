@@ -0,0 +1,264 @@
+//
+// DISCLAIMER
+//
+// Copyright 2024 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"google.golang.org/protobuf/proto"
+
+	common "github.com/arangodb-managed/apis/common/v1"
+	data "github.com/arangodb-managed/apis/data/v1"
+	platform "github.com/arangodb-managed/apis/platform/v1"
+	rm "github.com/arangodb-managed/apis/resourcemanager/v1"
+)
+
+// Preferences of the node size selector among the node sizes which fit
+const (
+	nodeSizePreferenceSmallest = "smallest"
+	nodeSizePreferenceCheapest = "cheapest"
+)
+
+// nodeSizeCPUSizes are the CPU sizes of node sizes, from small to large
+var nodeSizeCPUSizes = []string{"standard", "high"}
+
+// nodeSizeSelector is a convenient wrapper around the node size selector schema for easy parsing
+type nodeSizeSelector struct {
+	minMemorySize int
+	minCPUSize    string
+	minDiskSize   int
+	preference    string
+}
+
+// expandNodeSizeSelector gathers node size selector data from the terraform store
+func expandNodeSizeSelector(s []interface{}) (sel nodeSizeSelector) {
+	for _, v := range s {
+		if item, ok := v.(map[string]interface{}); ok {
+			if i, ok := item[deplNodeSizeSelectorMinMemorySizeFieldName]; ok {
+				sel.minMemorySize = i.(int)
+			}
+			if i, ok := item[deplNodeSizeSelectorMinCPUSizeFieldName]; ok {
+				sel.minCPUSize = i.(string)
+			}
+			if i, ok := item[deplNodeSizeSelectorMinDiskSizeFieldName]; ok {
+				sel.minDiskSize = i.(int)
+			}
+			if i, ok := item[deplNodeSizeSelectorPreferenceFieldName]; ok {
+				sel.preference = i.(string)
+			}
+		}
+	}
+	return
+}
+
+// resolveDeploymentNodeSizeDiff selects the node size of a deployment with a node size selector at plan time,
+// so that the selected node size is shown in the plan and kept in the state.
+// A node size selected before is kept until the selector or the model of the deployment changes.
+func resolveDeploymentNodeSizeDiff(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	selected := d.Get(deplSelectedNodeSizeIDFieldName).(string)
+	if !d.NewValueKnown(deplNodeSizeSelectorPath) {
+		return d.SetNewComputed(deplSelectedNodeSizeIDFieldName)
+	}
+	selectors := d.Get(deplNodeSizeSelectorPath).([]interface{})
+	if len(selectors) == 0 {
+		if selected != "" {
+			return d.SetNew(deplSelectedNodeSizeIDFieldName, "")
+		}
+		return nil
+	}
+	if d.Id() != "" && selected != "" && !d.HasChanges(deplNodeSizeSelectorPath, deplModelPath) {
+		return nil
+	}
+	for _, key := range []string{deplRegionPath, deplModelPath, deplNodeCountPath, deplNodeDiskSizePath, deplDiskPerformanceFieldName} {
+		if !d.NewValueKnown(key) {
+			return d.SetNewComputed(deplSelectedNodeSizeIDFieldName)
+		}
+	}
+	client := m.(*Client)
+	projectID, ok := deploymentProjectIDDiff(d, client)
+	if !ok {
+		return d.SetNewComputed(deplSelectedNodeSizeIDFieldName)
+	}
+
+	if err := client.Connect(ctx); err != nil {
+		tflog.Error(ctx, "Failed to connect to api", map[string]interface{}{"error": err})
+		return err
+	}
+
+	conf := configuration{
+		model:        d.Get(deplModelPath).(string),
+		nodeCount:    d.Get(deplNodeCountPath).(int),
+		nodeDiskSize: d.Get(deplNodeDiskSizePath).(int),
+	}
+	nodeSize, err := selectDeploymentNodeSize(ctx, client, d.Id(), projectID, d.Get(deplRegionPath).(string), conf, d.Get(deplDiskPerformanceFieldName).(string), expandNodeSizeSelector(selectors))
+	if err != nil {
+		return err
+	}
+	return d.SetNew(deplSelectedNodeSizeIDFieldName, nodeSize.GetId())
+}
+
+// selectDeploymentNodeSize selects the node size of a deployment with the given configuration by its node size selector.
+func selectDeploymentNodeSize(ctx context.Context, client *Client, deploymentID, projectID, regionID string, conf configuration, diskPerformanceID string, sel nodeSizeSelector) (*data.NodeSize, error) {
+	datac := data.NewDataServiceClient(client.conn)
+	list, err := datac.ListNodeSizes(client.apiContext(ctx), &data.NodeSizesRequest{
+		ProjectId:    projectID,
+		RegionId:     regionID,
+		DeploymentId: deploymentID,
+		Model:        conf.model,
+	})
+	if err != nil {
+		tflog.Error(ctx, "Failed to list node sizes", map[string]interface{}{"error": err, "project-id": projectID, "region-id": regionID})
+		return nil, err
+	}
+	candidates := filterNodeSizes(list.GetItems(), sel)
+	if len(candidates) == 0 {
+		ids := make([]string, 0, len(list.GetItems()))
+		for _, nodeSize := range list.GetItems() {
+			ids = append(ids, nodeSize.GetId())
+		}
+		return nil, fmt.Errorf("%s: no node size for model %q in region %s fits the selector, available are %s", deplNodeSizeSelectorPath, conf.model, regionID, strings.Join(ids, ", "))
+	}
+
+	var prices map[string]float32
+	if sel.preference == nodeSizePreferenceCheapest {
+		if prices, err = fetchNodeSizePrices(ctx, client, projectID, regionID, conf, diskPerformanceID, candidates); err != nil {
+			return nil, err
+		}
+	}
+	nodeSize := selectNodeSizeByPreference(candidates, sel.preference, prices)
+	tflog.Info(ctx, "Selected node size", map[string]interface{}{"node-size-id": nodeSize.GetId(), "preference": sel.preference})
+	return nodeSize, nil
+}
+
+// fetchNodeSizePrices calculates the price per hour of a deployment with the given configuration for each of the node sizes.
+func fetchNodeSizePrices(ctx context.Context, client *Client, projectID, regionID string, conf configuration, diskPerformanceID string, nodeSizes []*data.NodeSize) (map[string]float32, error) {
+	base, err := newDeploymentPriceRequest(ctx, client, projectID, regionID)
+	if err != nil {
+		return nil, err
+	}
+
+	datac := data.NewDataServiceClient(client.conn)
+	prices := make(map[string]float32, len(nodeSizes))
+	for _, nodeSize := range nodeSizes {
+		diskSize := int32(conf.nodeDiskSize)
+		if diskSize == 0 {
+			diskSize = nodeSize.GetMinDiskSize()
+		}
+		spec := &data.Deployment_ModelSpec{
+			Model:        conf.model,
+			NodeSizeId:   nodeSize.GetId(),
+			NodeCount:    int32(conf.nodeCount),
+			NodeDiskSize: diskSize,
+		}
+		// The node count defaults as when creating a deployment
+		if err := defaultDeploymentModel(ctx, client, projectID, regionID, spec); err != nil {
+			return nil, err
+		}
+		req := proto.Clone(base).(*data.DeploymentPriceRequest)
+		req.Model = spec.GetModel()
+		req.NodeSizeId = spec.GetNodeSizeId()
+		req.NodeCount = spec.GetNodeCount()
+		req.NodeDiskSize = spec.GetNodeDiskSize()
+		req.DiskPerformanceId = diskPerformanceID
+		price, err := datac.CalculateDeploymentPrice(client.apiContext(ctx), req)
+		if err != nil {
+			tflog.Error(ctx, "Failed to calculate deployment price", map[string]interface{}{"error": err, "node-size-id": nodeSize.GetId()})
+			return nil, err
+		}
+		prices[nodeSize.GetId()] = price.GetPricePerHour()
+	}
+	return prices, nil
+}
+
+// newDeploymentPriceRequest returns a request for the price of a deployment in the given project and region,
+// with the organization of the project and the cloud provider of the region filled in.
+func newDeploymentPriceRequest(ctx context.Context, client *Client, projectID, regionID string) (*data.DeploymentPriceRequest, error) {
+	rmc := rm.NewResourceManagerServiceClient(client.conn)
+	proj, err := rmc.GetProject(client.apiContext(ctx), &common.IDOptions{Id: projectID})
+	if err != nil {
+		tflog.Error(ctx, "Failed to get project", map[string]interface{}{"error": err, "project-id": projectID})
+		return nil, err
+	}
+	platformc := platform.NewPlatformServiceClient(client.conn)
+	region, err := platformc.GetRegion(client.apiContext(ctx), &common.IDOptions{Id: regionID})
+	if err != nil {
+		tflog.Error(ctx, "Failed to get region", map[string]interface{}{"error": err, "region-id": regionID})
+		return nil, err
+	}
+	return &data.DeploymentPriceRequest{
+		OrganizationId:  proj.GetOrganizationId(),
+		ProjectId:       projectID,
+		CloudProviderId: region.GetProviderId(),
+		CloudRegionId:   regionID,
+	}, nil
+}
+
+// filterNodeSizes returns the node sizes which offer at least the minimum memory, CPU and disk size of the selector.
+func filterNodeSizes(nodeSizes []*data.NodeSize, sel nodeSizeSelector) []*data.NodeSize {
+	var result []*data.NodeSize
+	for _, nodeSize := range nodeSizes {
+		if int(nodeSize.GetMemorySize()) < sel.minMemorySize {
+			continue
+		}
+		if sel.minCPUSize != "" && nodeSize.GetCpuSize() != sel.minCPUSize &&
+			slices.Index(nodeSizeCPUSizes, nodeSize.GetCpuSize()) < slices.Index(nodeSizeCPUSizes, sel.minCPUSize) {
+			continue
+		}
+		maxDiskSize := nodeSize.GetMaxDiskSize()
+		if len(nodeSize.GetDiskSizes()) > 0 {
+			maxDiskSize = slices.Max(nodeSize.GetDiskSizes())
+		}
+		if int(maxDiskSize) < sel.minDiskSize {
+			continue
+		}
+		result = append(result, nodeSize)
+	}
+	return result
+}
+
+// selectNodeSizeByPreference returns the smallest or cheapest of the given node sizes, or nil if none is given.
+// Node sizes are compared by memory size, then CPU size, then ID, and the cheapest by their prices first.
+func selectNodeSizeByPreference(nodeSizes []*data.NodeSize, preference string, prices map[string]float32) *data.NodeSize {
+	if len(nodeSizes) == 0 {
+		return nil
+	}
+	sorted := slices.Clone(nodeSizes)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		a, b := sorted[i], sorted[j]
+		if preference == nodeSizePreferenceCheapest && prices[a.GetId()] != prices[b.GetId()] {
+			return prices[a.GetId()] < prices[b.GetId()]
+		}
+		if a.GetMemorySize() != b.GetMemorySize() {
+			return a.GetMemorySize() < b.GetMemorySize()
+		}
+		if ca, cb := slices.Index(nodeSizeCPUSizes, a.GetCpuSize()), slices.Index(nodeSizeCPUSizes, b.GetCpuSize()); ca != cb {
+			return ca < cb
+		}
+		return a.GetId() < b.GetId()
+	})
+	return sorted[0]
+}
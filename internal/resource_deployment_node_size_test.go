@@ -0,0 +1,149 @@
+//
+// DISCLAIMER
+//
+// Copyright 2024 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	data "github.com/arangodb-managed/apis/data/v1"
+)
+
+// TestResourceDeploymentNodeSizeSelectorDefaultProject verifies that a deployment in the default project
+// of the provider is created with the node size selected by its node size selector.
+func TestResourceDeploymentNodeSizeSelectorDefaultProject(t *testing.T) {
+	if _, ok := os.LookupEnv("TF_ACC"); !ok {
+		t.Skip()
+	}
+	t.Parallel()
+
+	res := "terraform-deployment-" + acctest.RandString(10)
+	name := "deployment-" + acctest.RandString(10)
+	orgID, err := FetchOrganizationID()
+	require.NoError(t, err)
+	pid, err := FetchProjectID(context.Background(), orgID, testAccProvider)
+	require.NoError(t, err)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testProviderFactories,
+		CheckDestroy:      testAccCheckDestroyDeployment,
+		Steps: []resource.TestStep{
+			{
+				Config: testDeploymentNodeSizeSelectorConfig(res, name, pid),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("oasis_deployment."+res, deplProjectFieldName, pid),
+					resource.TestCheckResourceAttrSet("oasis_deployment."+res, deplSelectedNodeSizeIDFieldName),
+					resource.TestCheckResourceAttrPair("oasis_deployment."+res, deplSelectedNodeSizeIDFieldName, "oasis_deployment."+res, deplNodeSizeIdPath),
+				),
+			},
+			{
+				// The node size selected when creating the deployment is kept
+				Config:   testDeploymentNodeSizeSelectorConfig(res, name, pid),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+// testDeploymentNodeSizeSelectorConfig contains a deployment with a node size selector in the default project of the provider
+func testDeploymentNodeSizeSelectorConfig(resource, name, project string) string {
+	return fmt.Sprintf(`provider "oasis" {
+  project = "%s"
+}
+
+resource "oasis_deployment" "%s" {
+	terms_and_conditions_accepted = "true"
+	name        = "%s"
+	location {
+	  region = "gcp-europe-west4"
+	}
+	configuration {
+	  model = "oneshard"
+	  node_size_selector {
+	    min_memory_size = 8
+	    preference      = "smallest"
+	  }
+	}
+  }`, project, resource, name)
+}
+
+// TestExpandNodeSizeSelector tests the Oasis Deployment node size selector expansion.
+func TestExpandNodeSizeSelector(t *testing.T) {
+	raw := []interface{}{
+		map[string]interface{}{
+			deplNodeSizeSelectorMinMemorySizeFieldName: 8,
+			deplNodeSizeSelectorMinCPUSizeFieldName:    "high",
+			deplNodeSizeSelectorMinDiskSizeFieldName:   100,
+			deplNodeSizeSelectorPreferenceFieldName:    nodeSizePreferenceCheapest,
+		},
+	}
+	expected := nodeSizeSelector{minMemorySize: 8, minCPUSize: "high", minDiskSize: 100, preference: nodeSizePreferenceCheapest}
+	assert.Equal(t, expected, expandNodeSizeSelector(raw))
+}
+
+// TestFilterNodeSizes tests that only the node sizes offering the minimums of the selector are kept.
+func TestFilterNodeSizes(t *testing.T) {
+	nodeSizes := []*data.NodeSize{
+		{Id: "c4-a4", MemorySize: 4, CpuSize: "standard", MaxDiskSize: 200},
+		{Id: "c4-a8", MemorySize: 8, CpuSize: "standard", MaxDiskSize: 400},
+		{Id: "c8-a8", MemorySize: 8, CpuSize: "high", MaxDiskSize: 400},
+		{Id: "fixed", MemorySize: 16, CpuSize: "standard", DiskSizes: []int32{80, 160}},
+		{Id: "gpu", MemorySize: 16, CpuSize: "gpu", MaxDiskSize: 400},
+	}
+	ids := func(nodeSizes []*data.NodeSize) []string {
+		result := []string{}
+		for _, nodeSize := range nodeSizes {
+			result = append(result, nodeSize.GetId())
+		}
+		return result
+	}
+
+	assert.Equal(t, []string{"c4-a4", "c4-a8", "c8-a8", "fixed", "gpu"}, ids(filterNodeSizes(nodeSizes, nodeSizeSelector{})))
+	assert.Equal(t, []string{"c4-a8", "c8-a8", "fixed", "gpu"}, ids(filterNodeSizes(nodeSizes, nodeSizeSelector{minMemorySize: 8})))
+	assert.Equal(t, []string{"c4-a4", "c4-a8", "c8-a8", "fixed"}, ids(filterNodeSizes(nodeSizes, nodeSizeSelector{minCPUSize: "standard"})))
+	assert.Equal(t, []string{"c8-a8"}, ids(filterNodeSizes(nodeSizes, nodeSizeSelector{minCPUSize: "high"})))
+	assert.Equal(t, []string{"c4-a4", "c4-a8", "c8-a8", "gpu"}, ids(filterNodeSizes(nodeSizes, nodeSizeSelector{minDiskSize: 200})))
+	assert.Equal(t, []string{}, ids(filterNodeSizes(nodeSizes, nodeSizeSelector{minMemorySize: 32})))
+}
+
+// TestSelectNodeSizeByPreference tests the selection of the smallest or cheapest node size.
+func TestSelectNodeSizeByPreference(t *testing.T) {
+	nodeSizes := []*data.NodeSize{
+		{Id: "c8-a8", MemorySize: 8, CpuSize: "high"},
+		{Id: "c4-a8", MemorySize: 8, CpuSize: "standard"},
+		{Id: "c4-a16", MemorySize: 16, CpuSize: "standard"},
+	}
+	prices := map[string]float32{"c8-a8": 0.9, "c4-a8": 0.6, "c4-a16": 0.5}
+
+	assert.Equal(t, "c4-a8", selectNodeSizeByPreference(nodeSizes, nodeSizePreferenceSmallest, nil).GetId())
+	assert.Equal(t, "c4-a16", selectNodeSizeByPreference(nodeSizes, nodeSizePreferenceCheapest, prices).GetId())
+	assert.Equal(t, "c4-a8", selectNodeSizeByPreference(nodeSizes, nodeSizePreferenceCheapest, map[string]float32{}).GetId())
+	assert.Equal(t, "c8-a8", nodeSizes[0].GetId(), "the given node sizes are not reordered")
+	assert.Nil(t, selectNodeSizeByPreference(nil, nodeSizePreferenceSmallest, nil))
+}
@@ -25,13 +25,16 @@ import (
 	"fmt"
 	"os"
 	"testing"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	common "github.com/arangodb-managed/apis/common/v1"
 	data "github.com/arangodb-managed/apis/data/v1"
@@ -61,12 +64,117 @@ func TestResourceDeployment(t *testing.T) {
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttr("oasis_deployment."+res, deplNameFieldName, name),
 					resource.TestCheckResourceAttr("oasis_deployment."+res, deplDiskPerformanceFieldName, "dp30"),
+					resource.TestCheckResourceAttr("oasis_deployment."+res, deplStatusFieldName+".0."+deplStatusPhaseFieldName, deplPhaseReady),
+					resource.TestCheckResourceAttr("oasis_deployment."+res, deplStatusFieldName+".0."+deplStatusReadyFieldName, "true"),
+					resource.TestCheckResourceAttrSet("oasis_deployment."+res, deplEndpointFieldName),
+					resource.TestCheckResourceAttrSet("oasis_deployment."+res, deplCACertificatePEMFieldName),
+					resource.TestCheckResourceAttr("oasis_deployment."+res, deplRootUsernameFieldName, "root"),
+					resource.TestCheckResourceAttrSet("oasis_deployment."+res, deplRootPasswordFieldName),
 				),
 			},
+			{
+				ResourceName:            "oasis_deployment." + res,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{deplStatusFieldName},
+			},
 		},
 	})
 }
 
+// TestFlattenDeploymentStatus tests the Oasis Deployment status flattening for Terraform schema compatibility.
+func TestFlattenDeploymentStatus(t *testing.T) {
+	createdAt := time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)
+	depl := &data.Deployment{
+		CreatedAt: timestamppb.New(createdAt),
+		Status: &data.Deployment_Status{
+			Description:  "Ready",
+			Created:      true,
+			Ready:        true,
+			Bootstrapped: true,
+			IsUpToDate:   true,
+			Servers: []*data.Deployment_ServerStatus{
+				{Id: "agnt-1", Type: "agent", Ready: true, Ok: true, Version: "3.11.8"},
+				{Id: "prmr-1", Type: "dbserver", Creating: true},
+			},
+		},
+	}
+	expected := []interface{}{
+		map[string]interface{}{
+			deplStatusPhaseFieldName:        deplPhaseReady,
+			deplStatusDescriptionFieldName:  "Ready",
+			deplStatusReadyFieldName:        true,
+			deplStatusBootstrappedFieldName: true,
+			deplStatusUpgradingFieldName:    false,
+			deplStatusCreatedAtFieldName:    "2024-03-01T12:00:00Z",
+			deplStatusServersFieldName: []interface{}{
+				map[string]interface{}{
+					deplStatusServerIDFieldName:      "agnt-1",
+					deplStatusServerTypeFieldName:    "agent",
+					deplStatusServerStateFieldName:   "OK",
+					deplStatusServerReadyFieldName:   true,
+					deplStatusServerVersionFieldName: "3.11.8",
+				},
+				map[string]interface{}{
+					deplStatusServerIDFieldName:      "prmr-1",
+					deplStatusServerTypeFieldName:    "dbserver",
+					deplStatusServerStateFieldName:   "Creating",
+					deplStatusServerReadyFieldName:   false,
+					deplStatusServerVersionFieldName: "",
+				},
+			},
+		},
+	}
+	assert.Equal(t, expected, flattenDeploymentStatus(depl))
+}
+
+// TestFlattenDeploymentEndpoints tests the Oasis Deployment endpoints flattening for Terraform schema compatibility.
+func TestFlattenDeploymentEndpoints(t *testing.T) {
+	depl := &data.Deployment{
+		Status: &data.Deployment_Status{
+			Endpoint:                          "https://abc.arangodb.cloud:8529",
+			EndpointDefault:                   "https://abc.arangodb.cloud",
+			EndpointSelfSigned:                "https://abc.arangodb.cloud:18529",
+			EndpointPrivateEndpoint:           "https://abc.private.arangodb.cloud:8529",
+			EndpointPrivateEndpointDefault:    "https://abc.private.arangodb.cloud",
+			EndpointPrivateEndpointSelfSigned: "https://abc.private.arangodb.cloud:18529",
+		},
+	}
+	expected := map[string]interface{}{
+		deplEndpointFieldName:                  "https://abc.arangodb.cloud:8529",
+		deplEndpointDefaultFieldName:           "https://abc.arangodb.cloud",
+		deplEndpointSelfSignedFieldName:        "https://abc.arangodb.cloud:18529",
+		deplPrivateEndpointFieldName:           "https://abc.private.arangodb.cloud:8529",
+		deplPrivateEndpointDefaultFieldName:    "https://abc.private.arangodb.cloud",
+		deplPrivateEndpointSelfSignedFieldName: "https://abc.private.arangodb.cloud:18529",
+	}
+	assert.Equal(t, expected, flattenDeploymentEndpoints(depl))
+}
+
+// TestDeploymentPhase tests the phase derived from the status of a deployment.
+func TestDeploymentPhase(t *testing.T) {
+	tests := map[string]struct {
+		depl     *data.Deployment
+		expected string
+	}{
+		"no status":     {&data.Deployment{}, deplPhaseCreating},
+		"deleted":       {&data.Deployment{IsDeleted: true, Status: &data.Deployment_Status{Created: true}}, deplPhaseDeleting},
+		"bootstrapping": {&data.Deployment{Status: &data.Deployment_Status{Created: true}}, deplPhaseBootstrapping},
+		"upgrading":     {&data.Deployment{Status: &data.Deployment_Status{Created: true, Bootstrapped: true, Upgrading: true}}, deplPhaseUpgrading},
+		"restoring":     {&data.Deployment{Status: &data.Deployment_Status{Created: true, Bootstrapped: true, BackupRestoreStatus: &data.Deployment_BackupRestoreStatus{Restoring: true}}}, deplPhaseRestoring},
+		"not ready":     {&data.Deployment{Status: &data.Deployment_Status{Created: true, Bootstrapped: true}}, deplPhaseNotReady},
+		"updating":      {&data.Deployment{Status: &data.Deployment_Status{Created: true, Bootstrapped: true, Ready: true}}, deplPhaseUpdating},
+		"ready":         {&data.Deployment{Status: &data.Deployment_Status{Created: true, Bootstrapped: true, Ready: true, IsUpToDate: true}}, deplPhaseReady},
+		"pausing":       {&data.Deployment{IsPaused: true, Status: &data.Deployment_Status{Created: true, Bootstrapped: true, Servers: []*data.Deployment_ServerStatus{{Ready: true}, {Ready: false}}}}, deplPhasePausing},
+		"paused":        {&data.Deployment{IsPaused: true, Status: &data.Deployment_Status{Created: true, Bootstrapped: true, Servers: []*data.Deployment_ServerStatus{{Ready: false}}}}, deplPhasePaused},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, test.expected, deploymentPhase(test.depl))
+		})
+	}
+}
+
 // TestFlattenDeploymentResource tests the Oasis Deployment flattening for Terraform schema compatibility.
 func TestFlattenDeploymentResource(t *testing.T) {
 	deploymentProfileTestID := acctest.RandString(10)
@@ -131,6 +239,7 @@ func TestFlattenDeploymentResource(t *testing.T) {
 		deplDeploymentProfileIDFieldName:                  deploymentProfileTestID,
 		deplIsPlatformAuthEnabled:                         false,
 		deplDropVSTSupportFieldName:                       false,
+		deplIsPausedFieldName:                             false,
 	}
 	assert.Equal(t, expected, flattened)
 }
@@ -193,6 +302,7 @@ func TestFlattenDeploymentResourcePlatformAuthentication(t *testing.T) {
 		deplLockedFieldName:                               true,
 		deplIsPlatformAuthEnabled:                         true,
 		deplDropVSTSupportFieldName:                       false,
+		deplIsPausedFieldName:                             false,
 	}
 	assert.Equal(t, expected, flattened)
 }
@@ -216,7 +326,10 @@ func TestFlattenDeploymentResourceDropVSTSupport(t *testing.T) {
 			NodeCount:    3,
 			NodeDiskSize: 32,
 		},
+		IsPaused:                               true,
+		LastPausedAt:                           timestamppb.New(time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)),
 		IsScheduledRootPasswordRotationEnabled: true,
+		LastRootPasswordRotatedAt:              timestamppb.New(time.Date(2024, 2, 1, 3, 0, 0, 0, time.UTC)),
 		Locked:                                 true,
 		DropVstSupport:                         true,
 	}
@@ -252,7 +365,10 @@ func TestFlattenDeploymentResourceDropVSTSupport(t *testing.T) {
 		},
 		deplDiskPerformanceFieldName:                      "", // Not set
 		deplDisableScheduledRootPasswordRotationFieldName: false,
+		deplLastRootPasswordRotatedAtFieldName:            "2024-02-01T03:00:00Z",
 		deplLockedFieldName:                               true,
+		deplIsPausedFieldName:                             true,
+		deplLastPausedAtFieldName:                         "2024-03-01T12:00:00Z",
 		deplIsPlatformAuthEnabled:                         false,
 		deplDropVSTSupportFieldName:                       true,
 	}
@@ -316,6 +432,7 @@ func TestFlattenDeploymentResourceDisableFoxxAuth(t *testing.T) {
 		deplLockedFieldName:                               true,
 		deplIsPlatformAuthEnabled:                         false,
 		deplDropVSTSupportFieldName:                       false,
+		deplIsPausedFieldName:                             false,
 	}
 	assert.Equal(t, expected, flattened)
 }
@@ -385,6 +502,7 @@ func TestFlattenDeploymentResourceNotificationSettings(t *testing.T) {
 		deplLockedFieldName:                               true,
 		deplIsPlatformAuthEnabled:                         false,
 		deplDropVSTSupportFieldName:                       false,
+		deplIsPausedFieldName:                             false,
 	}
 	assert.Equal(t, expected, flattened)
 }
@@ -672,9 +790,10 @@ func testDeploymentConfig(resource, name, project string) string {
 
 // testAccCheckDestroyDeployment verifies the Terraform oasis_deployment resource cleanup.
 func testAccCheckDestroyDeployment(s *terraform.State) error {
+	ctx := context.Background()
 	client := testAccProvider.Meta().(*Client)
-	if err := client.Connect(); err != nil {
-		client.log.Error().Err(err).Msg("Failed to connect to api")
+	if err := client.Connect(ctx); err != nil {
+		tflog.Error(ctx, "Failed to connect to api", map[string]interface{}{"error": err})
 		return err
 	}
 	datac := data.NewDataServiceClient(client.conn)
@@ -684,7 +803,7 @@ func testAccCheckDestroyDeployment(s *terraform.State) error {
 			continue
 		}
 
-		if _, err := datac.GetDeployment(client.ctxWithToken, &common.IDOptions{Id: rs.Primary.ID}); !common.IsNotFound(err) {
+		if _, err := datac.GetDeployment(client.apiContext(ctx), &common.IDOptions{Id: rs.Primary.ID}); !common.IsNotFound(err) {
 			return fmt.Errorf("deployment still present")
 		}
 	}
@@ -0,0 +1,386 @@
+//
+// DISCLAIMER
+//
+// Copyright 2024 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	goversion "github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	common "github.com/arangodb-managed/apis/common/v1"
+	data "github.com/arangodb-managed/apis/data/v1"
+	platform "github.com/arangodb-managed/apis/platform/v1"
+	rm "github.com/arangodb-managed/apis/resourcemanager/v1"
+)
+
+var (
+	// Paths of the deployment attributes validated at plan time
+	deplRegionPath              = fmt.Sprintf("%s.0.%s", deplLocationFieldName, deplLocationRegionFieldName)
+	deplModelPath               = fmt.Sprintf("%s.0.%s", deplConfigurationFieldName, deplConfigurationModelFieldName)
+	deplNodeSizeIdPath          = fmt.Sprintf("%s.0.%s", deplConfigurationFieldName, deplConfigurationNodeSizeIdFieldName)
+	deplNodeCountPath           = fmt.Sprintf("%s.0.%s", deplConfigurationFieldName, deplConfigurationNodeCountFieldName)
+	deplNodeDiskSizePath        = fmt.Sprintf("%s.0.%s", deplConfigurationFieldName, deplConfigurationNodeDiskSizeFieldName)
+	deplMaximumNodeDiskSizePath = fmt.Sprintf("%s.0.%s", deplConfigurationFieldName, deplConfigurationMaximumNodeDiskSizeFieldName)
+	deplNodeSizeSelectorPath    = fmt.Sprintf("%s.0.%s", deplConfigurationFieldName, deplConfigurationNodeSizeSelectorFieldName)
+	deplDbVersionPath           = fmt.Sprintf("%s.0.%s", deplVersionFieldName, deplVersionDbVersionFieldName)
+)
+
+// Kinds of upgrades of the ArangoDB version of a deployment
+const (
+	versionUpgradePatch = "patch"
+	versionUpgradeMinor = "minor"
+	versionUpgradeMajor = "major"
+
+	// versionUpgradeUnsupportedSuffix is appended to the kind of an upgrade which is not a supported upgrade path
+	versionUpgradeUnsupportedSuffix = " (unsupported upgrade path)"
+)
+
+// versionListPageSize is the number of versions listed at once
+const versionListPageSize = 100
+
+// deploymentOptions holds what the API offers for a deployment in a given project and region.
+type deploymentOptions struct {
+	models           []*data.DeploymentModel
+	nodeSizes        []*data.NodeSize
+	limits           *data.ServersSpecLimits
+	diskPerformances []*data.DiskPerformance
+}
+
+// validateDeploymentConfigurationDiff validates the model, node size, node count, disk size and disk performance
+// of a deployment against the options offered for its project and region, so that invalid combinations
+// are rejected at plan time instead of during apply.
+// Validation is skipped while any of the involved values is not known yet.
+func validateDeploymentConfigurationDiff(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	if d.Id() != "" && !d.HasChanges(deplConfigurationFieldName, deplSelectedNodeSizeIDFieldName, deplDiskPerformanceFieldName) {
+		return nil
+	}
+	for _, key := range []string{deplRegionPath, deplModelPath, deplNodeSizeIdPath, deplNodeCountPath, deplNodeDiskSizePath, deplMaximumNodeDiskSizePath, deplSelectedNodeSizeIDFieldName, deplDiskPerformanceFieldName} {
+		if !d.NewValueKnown(key) {
+			return nil
+		}
+	}
+	client := m.(*Client)
+	projectID, ok := deploymentProjectIDDiff(d, client)
+	if !ok {
+		return nil
+	}
+
+	if err := client.Connect(ctx); err != nil {
+		tflog.Error(ctx, "Failed to connect to api", map[string]interface{}{"error": err})
+		return err
+	}
+
+	regionID := d.Get(deplRegionPath).(string)
+	conf := configuration{
+		model:               d.Get(deplModelPath).(string),
+		nodeSizeId:          d.Get(deplNodeSizeIdPath).(string),
+		nodeCount:           d.Get(deplNodeCountPath).(int),
+		nodeDiskSize:        d.Get(deplNodeDiskSizePath).(int),
+		maximumNodeDiskSize: d.Get(deplMaximumNodeDiskSizePath).(int),
+	}
+	if conf.nodeSizeId == "" {
+		conf.nodeSizeId = d.Get(deplSelectedNodeSizeIDFieldName).(string)
+	}
+	diskPerformanceID := d.Get(deplDiskPerformanceFieldName).(string)
+
+	if d.Id() == "" {
+		platformc := platform.NewPlatformServiceClient(client.conn)
+		region, err := platformc.GetRegion(client.apiContext(ctx), &common.IDOptions{Id: regionID})
+		if common.IsNotFound(err) {
+			return fmt.Errorf("%s: unknown region %q", deplRegionPath, regionID)
+		}
+		if err != nil {
+			tflog.Error(ctx, "Failed to get region", map[string]interface{}{"error": err, "region-id": regionID})
+			return err
+		}
+		if !region.GetAvailable() {
+			return fmt.Errorf("%s: region %q is not available", deplRegionPath, regionID)
+		}
+	}
+
+	opts, err := fetchDeploymentOptions(ctx, client, d.Id(), projectID, regionID, conf, diskPerformanceID)
+	if err != nil {
+		return err
+	}
+	return checkDeploymentConfiguration(conf, diskPerformanceID, regionID, opts)
+}
+
+// fetchDeploymentOptions lists the models, node sizes, node count limits and, if a disk performance is
+// configured, the disk performances offered for a deployment with the given configuration.
+func fetchDeploymentOptions(ctx context.Context, client *Client, deploymentID, projectID, regionID string, conf configuration, diskPerformanceID string) (deploymentOptions, error) {
+	var opts deploymentOptions
+	datac := data.NewDataServiceClient(client.conn)
+
+	models, err := datac.ListDeploymentModels(client.apiContext(ctx), &data.ListDeploymentModelsRequest{ProjectId: projectID, DeploymentId: deploymentID})
+	if err != nil {
+		tflog.Error(ctx, "Failed to list deployment models", map[string]interface{}{"error": err, "project-id": projectID})
+		return opts, err
+	}
+	opts.models = models.GetItems()
+
+	nodeSizes, err := datac.ListNodeSizes(client.apiContext(ctx), &data.NodeSizesRequest{
+		ProjectId:    projectID,
+		RegionId:     regionID,
+		DeploymentId: deploymentID,
+		Model:        conf.model,
+	})
+	if err != nil {
+		tflog.Error(ctx, "Failed to list node sizes", map[string]interface{}{"error": err, "project-id": projectID, "region-id": regionID})
+		return opts, err
+	}
+	opts.nodeSizes = nodeSizes.GetItems()
+
+	opts.limits, err = datac.GetServersSpecLimits(client.apiContext(ctx), &data.ServersSpecLimitsRequest{
+		ProjectId:    projectID,
+		RegionId:     regionID,
+		DeploymentId: deploymentID,
+	})
+	if err != nil {
+		tflog.Error(ctx, "Failed to get servers spec limits", map[string]interface{}{"error": err, "project-id": projectID, "region-id": regionID})
+		return opts, err
+	}
+
+	nodeSize := selectNodeSize(opts.nodeSizes, conf.nodeSizeId)
+	if diskPerformanceID != "" && nodeSize != nil {
+		diskSize := int32(conf.nodeDiskSize)
+		if diskSize == 0 {
+			diskSize = nodeSize.GetMinDiskSize()
+		}
+		diskPerformances, err := datac.ListDiskPerformances(client.apiContext(ctx), &data.ListDiskPerformancesRequest{
+			RegionId:         regionID,
+			NodeSizeId:       nodeSize.GetId(),
+			DbserverDiskSize: diskSize,
+		})
+		if err != nil {
+			tflog.Error(ctx, "Failed to list disk performances", map[string]interface{}{"error": err, "region-id": regionID, "node-size-id": nodeSize.GetId()})
+			return opts, err
+		}
+		opts.diskPerformances = diskPerformances.GetItems()
+	}
+	return opts, nil
+}
+
+// selectNodeSize returns the node size with the given ID, or the smallest node size if no ID is given,
+// which is the one used when creating a deployment without node size. It returns nil if none is found.
+func selectNodeSize(nodeSizes []*data.NodeSize, id string) *data.NodeSize {
+	var selected *data.NodeSize
+	for _, nodeSize := range nodeSizes {
+		if id != "" && nodeSize.GetId() == id {
+			return nodeSize
+		}
+		if id == "" && (selected == nil || nodeSize.GetMemorySize() < selected.GetMemorySize()) {
+			selected = nodeSize
+		}
+	}
+	return selected
+}
+
+// checkDeploymentConfiguration checks a deployment configuration against the options offered by the API.
+// All violations are returned, each prefixed with the path of the offending attribute.
+func checkDeploymentConfiguration(conf configuration, diskPerformanceID, regionID string, opts deploymentOptions) error {
+	var errs []error
+
+	modelIDs := make([]string, 0, len(opts.models))
+	for _, model := range opts.models {
+		modelIDs = append(modelIDs, model.GetId())
+	}
+	if !slices.Contains(modelIDs, conf.model) {
+		errs = append(errs, fmt.Errorf("%s: model %q is not available, expected one of %s", deplModelPath, conf.model, strings.Join(modelIDs, ", ")))
+	}
+
+	if conf.model == data.ModelDeveloper {
+		if conf.nodeCount != 0 && conf.nodeCount != 1 {
+			errs = append(errs, fmt.Errorf("%s: a deployment with model %q has exactly 1 node, got %d", deplNodeCountPath, conf.model, conf.nodeCount))
+		}
+	} else if limits := opts.limits.GetNodeCount(); conf.nodeCount != 0 && limits.GetMax() > 0 {
+		if int32(conf.nodeCount) < limits.GetMin() || int32(conf.nodeCount) > limits.GetMax() {
+			errs = append(errs, fmt.Errorf("%s: node count %d is outside of the allowed range %d-%d", deplNodeCountPath, conf.nodeCount, limits.GetMin(), limits.GetMax()))
+		}
+	}
+
+	nodeSize := selectNodeSize(opts.nodeSizes, conf.nodeSizeId)
+	if nodeSize == nil {
+		if conf.nodeSizeId != "" {
+			nodeSizeIDs := make([]string, 0, len(opts.nodeSizes))
+			for _, ns := range opts.nodeSizes {
+				nodeSizeIDs = append(nodeSizeIDs, ns.GetId())
+			}
+			errs = append(errs, fmt.Errorf("%s: node size %q is not available for model %q in region %s, expected one of %s", deplNodeSizeIdPath, conf.nodeSizeId, conf.model, regionID, strings.Join(nodeSizeIDs, ", ")))
+		}
+		return errors.Join(errs...)
+	}
+
+	maxDiskSize := nodeSize.GetMaxDiskSize()
+	if diskSizes := nodeSize.GetDiskSizes(); len(diskSizes) > 0 {
+		maxDiskSize = slices.Max(diskSizes)
+		if conf.nodeDiskSize != 0 && !slices.Contains(diskSizes, int32(conf.nodeDiskSize)) {
+			errs = append(errs, fmt.Errorf("%s: disk size %d is not offered for node size %q, expected one of %v", deplNodeDiskSizePath, conf.nodeDiskSize, nodeSize.GetId(), diskSizes))
+		}
+	} else if conf.nodeDiskSize != 0 && (int32(conf.nodeDiskSize) < nodeSize.GetMinDiskSize() || int32(conf.nodeDiskSize) > maxDiskSize) {
+		errs = append(errs, fmt.Errorf("%s: disk size %d is outside of the range %d-%d of node size %q", deplNodeDiskSizePath, conf.nodeDiskSize, nodeSize.GetMinDiskSize(), maxDiskSize, nodeSize.GetId()))
+	}
+	if conf.maximumNodeDiskSize != 0 {
+		if conf.maximumNodeDiskSize < conf.nodeDiskSize {
+			errs = append(errs, fmt.Errorf("%s: maximum disk size %d is smaller than the disk size %d", deplMaximumNodeDiskSizePath, conf.maximumNodeDiskSize, conf.nodeDiskSize))
+		} else if int32(conf.maximumNodeDiskSize) > maxDiskSize {
+			errs = append(errs, fmt.Errorf("%s: maximum disk size %d exceeds the maximum %d of node size %q", deplMaximumNodeDiskSizePath, conf.maximumNodeDiskSize, maxDiskSize, nodeSize.GetId()))
+		}
+	}
+
+	if diskPerformanceID != "" {
+		diskPerformanceIDs := make([]string, 0, len(opts.diskPerformances))
+		for _, dp := range opts.diskPerformances {
+			diskPerformanceIDs = append(diskPerformanceIDs, dp.GetId())
+		}
+		if !slices.Contains(diskPerformanceIDs, diskPerformanceID) {
+			errs = append(errs, fmt.Errorf("%s: disk performance %q is not offered for node size %q in region %s, expected one of %s", deplDiskPerformanceFieldName, diskPerformanceID, nodeSize.GetId(), regionID, strings.Join(diskPerformanceIDs, ", ")))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// validateDeploymentVersionDiff validates a change of the ArangoDB version of an existing deployment
+// against the available versions. Downgrades and unknown versions are rejected, and the kind of the upgrade
+// is set in the plan. Upgrades which are not a supported upgrade path from the current version are marked
+// as such in the planned kind, so that they are visible before they are applied.
+func validateDeploymentVersionDiff(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	if d.Id() == "" {
+		return nil
+	}
+	if !d.NewValueKnown(deplDbVersionPath) || !d.NewValueKnown(deplProjectFieldName) {
+		if d.HasChange(deplDbVersionPath) {
+			return d.SetNewComputed(deplVersionUpgradeFieldName)
+		}
+		return nil
+	}
+	o, n := d.GetChange(deplDbVersionPath)
+	current, target := o.(string), n.(string)
+	if current == "" || target == "" || current == target {
+		return nil
+	}
+
+	client := m.(*Client)
+	if err := client.Connect(ctx); err != nil {
+		tflog.Error(ctx, "Failed to connect to api", map[string]interface{}{"error": err})
+		return err
+	}
+	available, upgrades, err := fetchVersions(ctx, client, d.Get(deplProjectFieldName).(string), current)
+	if err != nil {
+		return err
+	}
+	kind, warning, err := checkVersionUpgrade(current, target, available, upgrades)
+	if err != nil {
+		return err
+	}
+	if warning != "" {
+		tflog.Warn(ctx, warning, map[string]interface{}{"deployment-id": d.Id()})
+		kind += versionUpgradeUnsupportedSuffix
+	}
+	return d.SetNew(deplVersionUpgradeFieldName, kind)
+}
+
+// fetchVersions lists the ArangoDB versions available to the organization of the given project,
+// and those which are a supported upgrade from the current version.
+func fetchVersions(ctx context.Context, client *Client, projectID, current string) ([]*data.Version, []*data.Version, error) {
+	rmc := rm.NewResourceManagerServiceClient(client.conn)
+	proj, err := rmc.GetProject(client.apiContext(ctx), &common.IDOptions{Id: projectID})
+	if err != nil {
+		tflog.Error(ctx, "Failed to get project", map[string]interface{}{"error": err, "project-id": projectID})
+		return nil, nil, err
+	}
+
+	available, err := listVersions(ctx, client, proj.GetOrganizationId(), "")
+	if err != nil {
+		return nil, nil, err
+	}
+	upgrades, err := listVersions(ctx, client, proj.GetOrganizationId(), current)
+	if err != nil {
+		return nil, nil, err
+	}
+	return available, upgrades, nil
+}
+
+// listVersions returns all ArangoDB versions available to the given organization,
+// restricted to the supported upgrades of currentVersion if set.
+func listVersions(ctx context.Context, client *Client, organizationID, currentVersion string) ([]*data.Version, error) {
+	datac := data.NewDataServiceClient(client.conn)
+	var result []*data.Version
+	for opts := (&common.ListOptions{PageSize: versionListPageSize}); ; opts.Page++ {
+		list, err := datac.ListVersions(client.apiContext(ctx), &data.ListVersionsRequest{
+			Options:        opts,
+			OrganizationId: organizationID,
+			CurrentVersion: currentVersion,
+		})
+		if err != nil {
+			tflog.Error(ctx, "Failed to list versions", map[string]interface{}{"error": err, "organization-id": organizationID, "current-version": currentVersion})
+			return nil, err
+		}
+		result = append(result, list.GetItems()...)
+		if len(list.GetItems()) < int(opts.PageSize) {
+			return result, nil
+		}
+	}
+}
+
+// checkVersionUpgrade checks a change of the ArangoDB version from current to target and returns the
+// kind of the upgrade. A warning is returned if the target is not a supported upgrade path from current.
+func checkVersionUpgrade(current, target string, available, upgrades []*data.Version) (kind, warning string, err error) {
+	currentVersion, err := goversion.NewVersion(current)
+	if err != nil {
+		return "", "", fmt.Errorf("%s: invalid current version %q: %w", deplDbVersionPath, current, err)
+	}
+	targetVersion, err := goversion.NewVersion(target)
+	if err != nil {
+		return "", "", fmt.Errorf("%s: invalid version %q: %w", deplDbVersionPath, target, err)
+	}
+	if targetVersion.LessThan(currentVersion) {
+		return "", "", fmt.Errorf("%s: downgrading from version %s to %s is not supported", deplDbVersionPath, current, target)
+	}
+
+	versions := make([]string, 0, len(available))
+	for _, v := range available {
+		versions = append(versions, v.GetVersion())
+	}
+	if !slices.Contains(versions, target) {
+		return "", "", fmt.Errorf("%s: version %s is not available, expected one of %s", deplDbVersionPath, target, strings.Join(versions, ", "))
+	}
+
+	if !slices.ContainsFunc(upgrades, func(v *data.Version) bool { return v.GetVersion() == target }) {
+		warning = fmt.Sprintf("Upgrading from version %s to %s is not a supported upgrade path", current, target)
+	}
+
+	currentSegments, targetSegments := currentVersion.Segments(), targetVersion.Segments()
+	switch {
+	case targetSegments[0] != currentSegments[0]:
+		kind = versionUpgradeMajor
+	case targetSegments[1] != currentSegments[1]:
+		kind = versionUpgradeMinor
+	default:
+		kind = versionUpgradePatch
+	}
+	return kind, warning, nil
+}
@@ -0,0 +1,187 @@
+//
+// DISCLAIMER
+//
+// Copyright 2024 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	data "github.com/arangodb-managed/apis/data/v1"
+)
+
+// TestValidateDeploymentConfigurationDefaultProject verifies that the configuration of a deployment
+// using the default project of the provider is validated at plan time.
+func TestValidateDeploymentConfigurationDefaultProject(t *testing.T) {
+	if _, ok := os.LookupEnv("TF_ACC"); !ok {
+		t.Skip()
+	}
+	t.Parallel()
+
+	res := "terraform-deployment-" + acctest.RandString(10)
+	orgID, err := FetchOrganizationID()
+	require.NoError(t, err)
+	pid, err := FetchProjectID(context.Background(), orgID, testAccProvider)
+	require.NoError(t, err)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testDeploymentInvalidNodeSizeConfig(res, pid),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`node size "unknown-node-size" is not available`),
+			},
+		},
+	})
+}
+
+// testDeploymentInvalidNodeSizeConfig contains a deployment with an unknown node size in the default project of the provider
+func testDeploymentInvalidNodeSizeConfig(resource, project string) string {
+	return fmt.Sprintf(`provider "oasis" {
+  project = "%s"
+}
+
+resource "oasis_deployment" "%s" {
+	terms_and_conditions_accepted = "true"
+	name        = "invalid-node-size"
+	location {
+	  region = "gcp-europe-west4"
+	}
+	configuration {
+	  model        = "oneshard"
+	  node_size_id = "unknown-node-size"
+	}
+  }`, project, resource)
+}
+
+// TestCheckDeploymentConfiguration tests the plan time validation of a deployment configuration.
+func TestCheckDeploymentConfiguration(t *testing.T) {
+	opts := deploymentOptions{
+		models: []*data.DeploymentModel{{Id: data.ModelOneShard}, {Id: data.ModelSharded}, {Id: data.ModelDeveloper}},
+		nodeSizes: []*data.NodeSize{
+			{Id: "c4-a8", MemorySize: 8, MinDiskSize: 10, MaxDiskSize: 400},
+			{Id: "c4-a4", MemorySize: 4, MinDiskSize: 10, MaxDiskSize: 200},
+			{Id: "fixed", MemorySize: 16, DiskSizes: []int32{80, 160}},
+		},
+		limits: &data.ServersSpecLimits{
+			NodeCount: &data.ServersSpecLimits_Limits{Min: 3, Max: 9},
+		},
+		diskPerformances: []*data.DiskPerformance{{Id: "dp30"}, {Id: "dp60"}},
+	}
+	valid := configuration{model: data.ModelOneShard, nodeSizeId: "c4-a8", nodeCount: 3, nodeDiskSize: 20, maximumNodeDiskSize: 40}
+
+	t.Run("valid configuration", func(tt *testing.T) {
+		assert.NoError(tt, checkDeploymentConfiguration(valid, "dp30", "gcp-europe-west4", opts))
+	})
+
+	t.Run("defaults are not validated", func(tt *testing.T) {
+		assert.NoError(tt, checkDeploymentConfiguration(configuration{model: data.ModelSharded}, "", "gcp-europe-west4", opts))
+	})
+
+	cases := []struct {
+		name     string
+		modify   func(c *configuration)
+		dp       string
+		expected string
+	}{
+		{"unknown model", func(c *configuration) { c.model = "unknown" }, "", `configuration.0.model: model "unknown" is not available`},
+		{"developer with 3 nodes", func(c *configuration) { c.model = data.ModelDeveloper }, "", "configuration.0.node_count: a deployment with model \"developer\" has exactly 1 node, got 3"},
+		{"too many nodes", func(c *configuration) { c.nodeCount = 12 }, "", "configuration.0.node_count: node count 12 is outside of the allowed range 3-9"},
+		{"unknown node size", func(c *configuration) { c.nodeSizeId = "c4-a64" }, "", `configuration.0.node_size_id: node size "c4-a64" is not available for model "oneshard" in region gcp-europe-west4, expected one of c4-a8, c4-a4, fixed`},
+		{"disk too small", func(c *configuration) { c.nodeDiskSize = 5 }, "", `configuration.0.node_disk_size: disk size 5 is outside of the range 10-400 of node size "c4-a8"`},
+		{"disk size not offered", func(c *configuration) { c.nodeSizeId = "fixed"; c.nodeDiskSize = 100; c.maximumNodeDiskSize = 0 }, "", `configuration.0.node_disk_size: disk size 100 is not offered for node size "fixed", expected one of [80 160]`},
+		{"maximum disk too small", func(c *configuration) { c.maximumNodeDiskSize = 10 }, "", "configuration.0.maximum_node_disk_size: maximum disk size 10 is smaller than the disk size 20"},
+		{"maximum disk too large", func(c *configuration) { c.nodeSizeId = "c4-a4"; c.maximumNodeDiskSize = 300 }, "", `configuration.0.maximum_node_disk_size: maximum disk size 300 exceeds the maximum 200 of node size "c4-a4"`},
+		{"disk performance not offered", func(c *configuration) {}, "dp100", `disk_performance: disk performance "dp100" is not offered for node size "c4-a8" in region gcp-europe-west4, expected one of dp30, dp60`},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(tt *testing.T) {
+			conf := valid
+			c.modify(&conf)
+			err := checkDeploymentConfiguration(conf, c.dp, "gcp-europe-west4", opts)
+			require.Error(tt, err)
+			assert.Contains(tt, err.Error(), c.expected)
+		})
+	}
+
+	t.Run("all violations are reported", func(tt *testing.T) {
+		conf := valid
+		conf.model = "unknown"
+		conf.nodeCount = 1
+		err := checkDeploymentConfiguration(conf, "dp100", "gcp-europe-west4", opts)
+		require.Error(tt, err)
+		assert.Contains(tt, err.Error(), deplModelPath)
+		assert.Contains(tt, err.Error(), deplNodeCountPath)
+		assert.Contains(tt, err.Error(), deplDiskPerformanceFieldName)
+	})
+}
+
+// TestSelectNodeSize tests that the configured node size, or else the smallest one, is selected.
+func TestSelectNodeSize(t *testing.T) {
+	nodeSizes := []*data.NodeSize{{Id: "c4-a8", MemorySize: 8}, {Id: "c4-a4", MemorySize: 4}}
+	assert.Equal(t, "c4-a8", selectNodeSize(nodeSizes, "c4-a8").GetId())
+	assert.Equal(t, "c4-a4", selectNodeSize(nodeSizes, "").GetId())
+	assert.Nil(t, selectNodeSize(nodeSizes, "unknown"))
+	assert.Nil(t, selectNodeSize(nil, ""))
+}
+
+// TestCheckVersionUpgrade tests the validation of version changes and the detection of the kind of upgrade.
+func TestCheckVersionUpgrade(t *testing.T) {
+	available := []*data.Version{{Version: "3.10.9"}, {Version: "3.11.1"}, {Version: "3.11.4"}, {Version: "3.12.0"}, {Version: "4.0.0"}}
+	upgrades := []*data.Version{{Version: "3.11.4"}, {Version: "3.12.0"}}
+
+	cases := []struct {
+		name     string
+		target   string
+		kind     string
+		warning  bool
+		expected string
+	}{
+		{name: "patch upgrade", target: "3.11.4", kind: versionUpgradePatch},
+		{name: "minor upgrade", target: "3.12.0", kind: versionUpgradeMinor},
+		{name: "unsupported major upgrade", target: "4.0.0", kind: versionUpgradeMajor, warning: true},
+		{name: "downgrade", target: "3.10.9", expected: "version.0.db_version: downgrading from version 3.11.1 to 3.10.9 is not supported"},
+		{name: "unknown version", target: "3.11.99", expected: "version.0.db_version: version 3.11.99 is not available, expected one of 3.10.9, 3.11.1, 3.11.4, 3.12.0, 4.0.0"},
+		{name: "invalid version", target: "latest", expected: `version.0.db_version: invalid version "latest"`},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(tt *testing.T) {
+			kind, warning, err := checkVersionUpgrade("3.11.1", c.target, available, upgrades)
+			if c.expected != "" {
+				require.Error(tt, err)
+				assert.Contains(tt, err.Error(), c.expected)
+				return
+			}
+			require.NoError(tt, err)
+			assert.Equal(tt, c.kind, kind)
+			assert.Equal(tt, c.warning, warning != "")
+		})
+	}
+}
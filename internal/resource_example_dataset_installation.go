@@ -24,6 +24,7 @@ import (
 	"context"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
@@ -50,6 +51,14 @@ func resourceExampleDatasetInstallation() *schema.Resource {
 		CreateContext: resourceExampleDatasetInstallationCreate,
 		ReadContext:   resourceExampleDatasetInstallationRead,
 		DeleteContext: resourceExampleDatasetInstallationDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(defaultOperationTimeout),
+			Read:   schema.DefaultTimeout(readOperationTimeout),
+			Delete: schema.DefaultTimeout(defaultOperationTimeout),
+		},
 
 		Schema: map[string]*schema.Schema{
 			datasetDeploymentIdFieldName: {
@@ -104,16 +113,16 @@ func resourceExampleDatasetInstallation() *schema.Resource {
 
 func resourceExampleDatasetInstallationCreate(ctx context.Context, data *schema.ResourceData, m interface{}) diag.Diagnostics {
 	client := m.(*Client)
-	if err := client.Connect(); err != nil {
-		client.log.Error().Err(err).Msg("Failed to connect to api")
+	if err := client.Connect(ctx); err != nil {
+		tflog.Error(ctx, "Failed to connect to api", map[string]interface{}{"error": err})
 		return diag.FromErr(err)
 	}
 
 	examplec := example.NewExampleDatasetServiceClient(client.conn)
 	req := expandExampleDatasetInstallation(data)
-	resp, err := examplec.CreateExampleDatasetInstallation(client.ctxWithToken, req)
+	resp, err := examplec.CreateExampleDatasetInstallation(client.apiContext(ctx), req)
 	if err != nil {
-		client.log.Error().Err(err).Msg("Failed to create example dataset installation.")
+		tflog.Error(ctx, "Failed to create example dataset installation.", map[string]interface{}{"error": err})
 		return diag.FromErr(err)
 	}
 
@@ -134,25 +143,27 @@ func expandExampleDatasetInstallation(data *schema.ResourceData) *example.Exampl
 
 func resourceExampleDatasetInstallationRead(ctx context.Context, data *schema.ResourceData, m interface{}) diag.Diagnostics {
 	client := m.(*Client)
-	if err := client.Connect(); err != nil {
-		client.log.Error().Err(err).Msg("Failed to connect to api")
-		data.SetId("")
+	if err := client.Connect(ctx); err != nil {
+		tflog.Error(ctx, "Failed to connect to api", map[string]interface{}{"error": err})
 		return diag.FromErr(err)
 	}
 
 	examplec := example.NewExampleDatasetServiceClient(client.conn)
-	response, err := examplec.GetExampleDatasetInstallation(client.ctxWithToken, &common.IDOptions{
+	response, err := examplec.GetExampleDatasetInstallation(client.apiContext(ctx), &common.IDOptions{
 		Id: data.Id(),
 	})
-	if err != nil {
-		client.log.Error().Str("installation-id", data.Id()).Err(err).Msg("Failed to get example dataset installation.")
+	if isRemoved(response, err) {
+		tflog.Warn(ctx, "Example dataset installation has been deleted, removing it from state", map[string]interface{}{"installation-id": data.Id()})
 		data.SetId("")
+		return nil
+	}
+	if err != nil {
+		tflog.Error(ctx, "Failed to find example dataset installation", map[string]interface{}{"error": err, "installation-id": data.Id()})
 		return diag.FromErr(err)
 	}
 
 	for k, v := range flattenExampleDatasetInstallation(response) {
 		if err := data.Set(k, v); err != nil {
-			data.SetId("")
 			return diag.FromErr(err)
 		}
 	}
@@ -183,14 +194,14 @@ func flattenStatus(status *example.ExampleDatasetInstallation_Status) []interfac
 
 func resourceExampleDatasetInstallationDelete(ctx context.Context, data *schema.ResourceData, m interface{}) diag.Diagnostics {
 	client := m.(*Client)
-	if err := client.Connect(); err != nil {
-		client.log.Error().Err(err).Msg("Failed to connect to api")
+	if err := client.Connect(ctx); err != nil {
+		tflog.Error(ctx, "Failed to connect to api", map[string]interface{}{"error": err})
 		return diag.FromErr(err)
 	}
 
 	examplec := example.NewExampleDatasetServiceClient(client.conn)
-	if _, err := examplec.DeleteExampleDatasetInstallation(client.ctxWithToken, &common.IDOptions{Id: data.Id()}); err != nil {
-		client.log.Error().Err(err).Str("installation-id", data.Id()).Msg("Failed to delete installation")
+	if _, err := examplec.DeleteExampleDatasetInstallation(client.apiContext(ctx), &common.IDOptions{Id: data.Id()}); err != nil {
+		tflog.Error(ctx, "Failed to delete installation", map[string]interface{}{"error": err, "installation-id": data.Id()})
 		return diag.FromErr(err)
 	}
 	data.SetId("") // called automatically, but added to be explicit
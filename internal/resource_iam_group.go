@@ -24,6 +24,7 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
@@ -47,6 +48,15 @@ func resourceIAMGroup() *schema.Resource {
 		ReadContext:   resourceIAMGroupRead,
 		UpdateContext: resourceIAMGroupUpdate,
 		DeleteContext: resourceIAMGroupDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(shortOperationTimeout),
+			Read:   schema.DefaultTimeout(readOperationTimeout),
+			Update: schema.DefaultTimeout(shortOperationTimeout),
+			Delete: schema.DefaultTimeout(shortOperationTimeout),
+		},
 		Schema: map[string]*schema.Schema{
 			iamGroupNameFieldName: {
 				Type:        schema.TypeString,
@@ -71,8 +81,8 @@ func resourceIAMGroup() *schema.Resource {
 // sets the ID of a given IAM Group once the creation is successful. This will be stored in local Terraform store.
 func resourceIAMGroupCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	client := m.(*Client)
-	if err := client.Connect(); err != nil {
-		client.log.Error().Err(err).Msg("Failed to connect to api")
+	if err := client.Connect(ctx); err != nil {
+		tflog.Error(ctx, "Failed to connect to api", map[string]interface{}{"error": err})
 		return diag.FromErr(err)
 	}
 	iamc := iam.NewIAMServiceClient(client.conn)
@@ -80,9 +90,9 @@ func resourceIAMGroupCreate(ctx context.Context, d *schema.ResourceData, m inter
 	if err != nil {
 		return diag.FromErr(err)
 	}
-	result, err := iamc.CreateGroup(client.ctxWithToken, expanded)
+	result, err := iamc.CreateGroup(client.apiContext(ctx), expanded)
 	if err != nil {
-		client.log.Error().Err(err).Msg("Failed to create IAM Group")
+		tflog.Error(ctx, "Failed to create IAM Group", map[string]interface{}{"error": err})
 		return diag.FromErr(err)
 	}
 	if result != nil {
@@ -125,16 +135,20 @@ func flattenIAMGroupResource(iamGroup *iam.Group) map[string]interface{} {
 // resourceIAMGroupRead handles the read lifecycle of the IAM Group resource.
 func resourceIAMGroupRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	client := m.(*Client)
-	if err := client.Connect(); err != nil {
-		client.log.Error().Err(err).Msg("Failed to connect to api")
+	if err := client.Connect(ctx); err != nil {
+		tflog.Error(ctx, "Failed to connect to api", map[string]interface{}{"error": err})
 		return diag.FromErr(err)
 	}
 
 	iamc := iam.NewIAMServiceClient(client.conn)
-	p, err := iamc.GetGroup(client.ctxWithToken, &common.IDOptions{Id: d.Id()})
-	if err != nil || p == nil {
-		client.log.Error().Err(err).Str("iam-group-id", d.Id()).Msg("Failed to find IAM group")
+	p, err := iamc.GetGroup(client.apiContext(ctx), &common.IDOptions{Id: d.Id()})
+	if isRemoved(p, err) {
+		tflog.Warn(ctx, "IAM group has been deleted, removing it from state", map[string]interface{}{"iam-group-id": d.Id()})
 		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		tflog.Error(ctx, "Failed to find IAM group", map[string]interface{}{"error": err, "iam-group-id": d.Id()})
 		return diag.FromErr(err)
 	}
 
@@ -149,14 +163,14 @@ func resourceIAMGroupRead(ctx context.Context, d *schema.ResourceData, m interfa
 // resourceIAMGroupDelete will be called once the resource is destroyed.
 func resourceIAMGroupDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	client := m.(*Client)
-	if err := client.Connect(); err != nil {
-		client.log.Error().Err(err).Msg("Failed to connect to api")
+	if err := client.Connect(ctx); err != nil {
+		tflog.Error(ctx, "Failed to connect to api", map[string]interface{}{"error": err})
 		return diag.FromErr(err)
 	}
 
 	iamc := iam.NewIAMServiceClient(client.conn)
-	if _, err := iamc.DeleteGroup(client.ctxWithToken, &common.IDOptions{Id: d.Id()}); err != nil {
-		client.log.Error().Err(err).Str("iam-group-id", d.Id()).Msg("Failed to delete IAM Group")
+	if _, err := iamc.DeleteGroup(client.apiContext(ctx), &common.IDOptions{Id: d.Id()}); err != nil {
+		tflog.Error(ctx, "Failed to delete IAM Group", map[string]interface{}{"error": err, "iam-group-id": d.Id()})
 		return diag.FromErr(err)
 	}
 	d.SetId("") // called automatically, but added to be explicit
@@ -166,16 +180,19 @@ func resourceIAMGroupDelete(ctx context.Context, d *schema.ResourceData, m inter
 // resourceIAMGroupUpdate will take a resource diff and apply changes accordingly if there are any.
 func resourceIAMGroupUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	client := m.(*Client)
-	if err := client.Connect(); err != nil {
-		client.log.Error().Err(err).Msg("Failed to connect to api")
+	if err := client.Connect(ctx); err != nil {
+		tflog.Error(ctx, "Failed to connect to api", map[string]interface{}{"error": err})
 		return diag.FromErr(err)
 	}
 
 	iamc := iam.NewIAMServiceClient(client.conn)
-	iamGroup, err := iamc.GetGroup(client.ctxWithToken, &common.IDOptions{Id: d.Id()})
+	iamGroup, err := iamc.GetGroup(client.apiContext(ctx), &common.IDOptions{Id: d.Id()})
+	if isRemoved(iamGroup, err) {
+		tflog.Warn(ctx, "IAM group has been deleted, it is removed from state by the next refresh", map[string]interface{}{"iam-group-id": d.Id()})
+		return diag.Errorf("IAM group %s has been deleted", d.Id())
+	}
 	if err != nil {
-		client.log.Error().Err(err).Msg("Failed to get IAM Group")
-		d.SetId("")
+		tflog.Error(ctx, "Failed to get IAM Group", map[string]interface{}{"error": err, "iam-group-id": d.Id()})
 		return diag.FromErr(err)
 	}
 	// Main fields
@@ -189,9 +206,9 @@ func resourceIAMGroupUpdate(ctx context.Context, d *schema.ResourceData, m inter
 		iamGroup.Description = d.Get(iamGroupDescriptionFieldName).(string)
 	}
 
-	res, err := iamc.UpdateGroup(client.ctxWithToken, iamGroup)
+	res, err := iamc.UpdateGroup(client.apiContext(ctx), iamGroup)
 	if err != nil {
-		client.log.Error().Err(err).Msg("Failed to update IAM Group")
+		tflog.Error(ctx, "Failed to update IAM Group", map[string]interface{}{"error": err})
 		return diag.FromErr(err)
 	} else {
 		d.SetId(res.GetId())
@@ -21,11 +21,13 @@
 package provider
 
 import (
+	"context"
 	"fmt"
 	"os"
 	"regexp"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -65,15 +67,21 @@ func TestAccResourceIAMGroup(t *testing.T) {
 					resource.TestCheckResourceAttr("oasis_iam_group.oasis_iam_group_test", iamGroupNameFieldName, name),
 				),
 			},
+			{
+				ResourceName:      "oasis_iam_group.oasis_iam_group_test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
 
 // testAccCheckDestroyIAMGroup verifies the Terraform oasis_iam_group resource cleanup.
 func testAccCheckDestroyIAMGroup(s *terraform.State) error {
+	ctx := context.Background()
 	client := testAccProvider.Meta().(*Client)
-	if err := client.Connect(); err != nil {
-		client.log.Error().Err(err).Msg("Failed to connect to api")
+	if err := client.Connect(ctx); err != nil {
+		tflog.Error(ctx, "Failed to connect to api", map[string]interface{}{"error": err})
 		return err
 	}
 	iamc := iam.NewIAMServiceClient(client.conn)
@@ -83,7 +91,7 @@ func testAccCheckDestroyIAMGroup(s *terraform.State) error {
 			continue
 		}
 
-		if _, err := iamc.GetGroup(client.ctxWithToken, &common.IDOptions{Id: rs.Primary.ID}); err == nil {
+		if _, err := iamc.GetGroup(client.apiContext(ctx), &common.IDOptions{Id: rs.Primary.ID}); err == nil {
 			return fmt.Errorf("iam group still present")
 		}
 	}
@@ -25,6 +25,7 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
@@ -49,6 +50,14 @@ func resourceIAMPolicy() *schema.Resource {
 		CreateContext: resourceIAMPolicyCreate,
 		ReadContext:   resourceIAMPolicyRead,
 		DeleteContext: resourceIAMPolicyDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(shortOperationTimeout),
+			Read:   schema.DefaultTimeout(readOperationTimeout),
+			Delete: schema.DefaultTimeout(shortOperationTimeout),
+		},
 		Schema: map[string]*schema.Schema{
 			iamPolicyURLFieldName: {
 				Type:        schema.TypeString,
@@ -91,8 +100,8 @@ func resourceIAMPolicy() *schema.Resource {
 // sets the ID of a given IAM Policy once the creation is successful. This will be stored in local Terraform store.
 func resourceIAMPolicyCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	client := m.(*Client)
-	if err := client.Connect(); err != nil {
-		client.log.Error().Err(err).Msg("Failed to connect to api")
+	if err := client.Connect(ctx); err != nil {
+		tflog.Error(ctx, "Failed to connect to api", map[string]interface{}{"error": err})
 		return diag.FromErr(err)
 	}
 	iamc := iam.NewIAMServiceClient(client.conn)
@@ -100,9 +109,9 @@ func resourceIAMPolicyCreate(ctx context.Context, d *schema.ResourceData, m inte
 	if err != nil {
 		return diag.FromErr(err)
 	}
-	result, err := iamc.AddRoleBindings(client.ctxWithToken, expanded)
+	result, err := iamc.AddRoleBindings(client.apiContext(ctx), expanded)
 	if err != nil {
-		client.log.Error().Err(err).Msg("Failed to create IAM Policy")
+		tflog.Error(ctx, "Failed to create IAM Policy", map[string]interface{}{"error": err})
 		return diag.FromErr(err)
 	}
 	if result != nil {
@@ -171,39 +180,54 @@ func flattenIAMPolicyResource(policy *iam.Policy) map[string]interface{} {
 	}
 }
 
-// flattenIAMPolicyBindings will take an IAM Policy Binding part of an IAM Policy and create a sub map for terraform schema.
+// flattenIAMPolicyBindings will take the IAM Policy Bindings part of an IAM Policy and create a sub map for terraform schema.
+// The member ID of each binding is split into the group or user ID it was created from.
 func flattenIAMPolicyBindings(iamBindings []*iam.RoleBinding) []interface{} {
-	var bindings = make(map[string]interface{})
+	bindings := make([]interface{}, 0, len(iamBindings))
 	for _, binding := range iamBindings {
-		bindings[iamPolicyRoleFieldName] = binding.GetRoleId()
-		if strings.Contains(binding.GetMemberId(), "group") {
-			bindings[iamPolicyGroupFieldName] = binding.GetMemberId()
+		flattened := map[string]interface{}{
+			iamPolicyRoleFieldName: binding.GetRoleId(),
 		}
-		if strings.Contains(binding.GetMemberId(), "user") {
-			bindings[iamPolicyUserFieldName] = binding.GetMemberId()
+		memberID := binding.GetMemberId()
+		if groupID := strings.TrimPrefix(memberID, iam.CreateMemberIDFromGroupID("")); groupID != memberID {
+			flattened[iamPolicyGroupFieldName] = groupID
 		}
+		if userID := strings.TrimPrefix(memberID, iam.CreateMemberIDFromUserID("")); userID != memberID {
+			flattened[iamPolicyUserFieldName] = userID
+		}
+		bindings = append(bindings, flattened)
 	}
-	return []interface{}{
-		bindings,
-	}
+	return bindings
 }
 
 // resourceIAMPolicyRead handles the read lifecycle of the IAM Policy resource.
 func resourceIAMPolicyRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	client := m.(*Client)
-	if err := client.Connect(); err != nil {
-		client.log.Error().Err(err).Msg("Failed to connect to api")
+	if err := client.Connect(ctx); err != nil {
+		tflog.Error(ctx, "Failed to connect to api", map[string]interface{}{"error": err})
 		return diag.FromErr(err)
 	}
 
 	iamc := iam.NewIAMServiceClient(client.conn)
-	p, err := iamc.GetPolicy(client.ctxWithToken, &common.URLOptions{Url: d.Id()})
-	if err != nil || p == nil {
-		client.log.Error().Err(err).Str("iam-policy-id", d.Id()).Msg("Failed to find IAM policy")
+	p, err := iamc.GetPolicy(client.apiContext(ctx), &common.URLOptions{Url: d.Id()})
+	if common.IsNotFound(err) {
+		tflog.Warn(ctx, "IAM policy has been deleted, removing it from state", map[string]interface{}{"iam-policy-id": d.Id()})
 		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		tflog.Error(ctx, "Failed to find IAM policy", map[string]interface{}{"error": err, "iam-policy-id": d.Id()})
 		return diag.FromErr(err)
 	}
 
+	// Bindings added outside of this resource are not managed by it, unless it is imported
+	if v, ok := d.GetOk(iamPolicyRoleBindingFieldName); ok {
+		managed, diags := expandIAMPolicyBindings(v.([]interface{}))
+		if diags.HasError() {
+			return diags
+		}
+		p.Bindings = filterIAMPolicyBindings(p.GetBindings(), managed)
+	}
 	for k, v := range flattenIAMPolicyResource(p) {
 		if err := d.Set(k, v); err != nil {
 			return diag.FromErr(err)
@@ -212,6 +236,20 @@ func resourceIAMPolicyRead(ctx context.Context, d *schema.ResourceData, m interf
 	return nil
 }
 
+// filterIAMPolicyBindings returns the managed bindings which are part of the given bindings, in the order of the managed bindings.
+func filterIAMPolicyBindings(bindings, managed []*iam.RoleBinding) []*iam.RoleBinding {
+	result := make([]*iam.RoleBinding, 0, len(managed))
+	for _, m := range managed {
+		for _, b := range bindings {
+			if b.GetRoleId() == m.GetRoleId() && b.GetMemberId() == m.GetMemberId() {
+				result = append(result, b)
+				break
+			}
+		}
+	}
+	return result
+}
+
 // resourceIAMPolicyDelete will be called once the resource is destroyed.
 func resourceIAMPolicyDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	d.SetId("") // called automatically, but added to be explicit
@@ -41,6 +41,10 @@ func TestFlattenIAMPolicy(t *testing.T) {
 				RoleId:   "test-role",
 				MemberId: "group:300480957",
 			},
+			{
+				RoleId:   "test-role",
+				MemberId: "user:google-oauth2|1234",
+			},
 		},
 	}
 
@@ -48,9 +52,13 @@ func TestFlattenIAMPolicy(t *testing.T) {
 		iamPolicyURLFieldName: organization,
 		iamPolicyRoleBindingFieldName: []interface{}{
 			map[string]interface{}{
-				iamPolicyGroupFieldName: "group:300480957",
+				iamPolicyGroupFieldName: "300480957",
 				iamPolicyRoleFieldName:  "test-role",
 			},
+			map[string]interface{}{
+				iamPolicyUserFieldName: "google-oauth2|1234",
+				iamPolicyRoleFieldName: "test-role",
+			},
 		},
 	}
 
@@ -58,6 +66,23 @@ func TestFlattenIAMPolicy(t *testing.T) {
 	assert.Equal(t, expected, flattened)
 }
 
+// TestFilterIAMPolicyBindings tests that only the managed bindings of a policy are kept, in their order.
+func TestFilterIAMPolicyBindings(t *testing.T) {
+	bindings := []*iam.RoleBinding{
+		{RoleId: "test-role", MemberId: "user:google-oauth2|1234"},
+		{RoleId: "other-role", MemberId: "group:300480957"},
+		{RoleId: "test-role", MemberId: "group:300480957"},
+	}
+	managed := []*iam.RoleBinding{
+		{RoleId: "test-role", MemberId: "group:300480957"},
+		{RoleId: "test-role", MemberId: "user:google-oauth2|1234"},
+		{RoleId: "removed-role", MemberId: "group:300480957"},
+	}
+	expected := []*iam.RoleBinding{bindings[2], bindings[0]}
+	assert.Equal(t, expected, filterIAMPolicyBindings(bindings, managed))
+	assert.Empty(t, filterIAMPolicyBindings(bindings, nil))
+}
+
 // TestExpandIAMPolicy tests the Oasis IAM Policy expansion for Terraform schema compatibility.
 func TestExpandIAMPolicy(t *testing.T) {
 	organization := fmt.Sprintf("/Organization/%s", acctest.RandString(10))
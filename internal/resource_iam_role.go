@@ -24,6 +24,7 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
@@ -48,6 +49,15 @@ func resourceIAMRole() *schema.Resource {
 		ReadContext:   resourceIAMRoleRead,
 		UpdateContext: resourceIAMRoleUpdate,
 		DeleteContext: resourceIAMRoleDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(shortOperationTimeout),
+			Read:   schema.DefaultTimeout(readOperationTimeout),
+			Update: schema.DefaultTimeout(shortOperationTimeout),
+			Delete: schema.DefaultTimeout(shortOperationTimeout),
+		},
 		Schema: map[string]*schema.Schema{
 			iamRoleNameFieldName: {
 				Type:        schema.TypeString,
@@ -79,8 +89,8 @@ func resourceIAMRole() *schema.Resource {
 // sets the ID of a given IAM Role once the creation is successful. This will be stored in local Terraform store.
 func resourceIAMRoleCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	client := m.(*Client)
-	if err := client.Connect(); err != nil {
-		client.log.Error().Err(err).Msg("Failed to connect to api")
+	if err := client.Connect(ctx); err != nil {
+		tflog.Error(ctx, "Failed to connect to api", map[string]interface{}{"error": err})
 		return diag.FromErr(err)
 	}
 	iamc := iam.NewIAMServiceClient(client.conn)
@@ -88,9 +98,9 @@ func resourceIAMRoleCreate(ctx context.Context, d *schema.ResourceData, m interf
 	if err != nil {
 		return diag.FromErr(err)
 	}
-	result, err := iamc.CreateRole(client.ctxWithToken, expanded)
+	result, err := iamc.CreateRole(client.apiContext(ctx), expanded)
 	if err != nil {
-		client.log.Error().Err(err).Msg("Failed to create IAM Role")
+		tflog.Error(ctx, "Failed to create IAM Role", map[string]interface{}{"error": err})
 		return diag.FromErr(err)
 	}
 	if result != nil {
@@ -157,16 +167,20 @@ func flattenIAMRoleResource(iamRole *iam.Role) map[string]interface{} {
 // resourceIAMRoleRead handles the read lifecycle of the IAM Role resource.
 func resourceIAMRoleRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	client := m.(*Client)
-	if err := client.Connect(); err != nil {
-		client.log.Error().Err(err).Msg("Failed to connect to api")
+	if err := client.Connect(ctx); err != nil {
+		tflog.Error(ctx, "Failed to connect to api", map[string]interface{}{"error": err})
 		return diag.FromErr(err)
 	}
 
 	iamc := iam.NewIAMServiceClient(client.conn)
-	p, err := iamc.GetRole(client.ctxWithToken, &common.IDOptions{Id: d.Id()})
-	if err != nil || p == nil {
-		client.log.Error().Err(err).Str("iam-role-id", d.Id()).Msg("Failed to find IAM role")
+	p, err := iamc.GetRole(client.apiContext(ctx), &common.IDOptions{Id: d.Id()})
+	if isRemoved(p, err) {
+		tflog.Warn(ctx, "IAM role has been deleted, removing it from state", map[string]interface{}{"iam-role-id": d.Id()})
 		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		tflog.Error(ctx, "Failed to find IAM role", map[string]interface{}{"error": err, "iam-role-id": d.Id()})
 		return diag.FromErr(err)
 	}
 
@@ -181,14 +195,14 @@ func resourceIAMRoleRead(ctx context.Context, d *schema.ResourceData, m interfac
 // resourceIAMRoleDelete will be called once the resource is destroyed.
 func resourceIAMRoleDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	client := m.(*Client)
-	if err := client.Connect(); err != nil {
-		client.log.Error().Err(err).Msg("Failed to connect to api")
+	if err := client.Connect(ctx); err != nil {
+		tflog.Error(ctx, "Failed to connect to api", map[string]interface{}{"error": err})
 		return diag.FromErr(err)
 	}
 
 	iamc := iam.NewIAMServiceClient(client.conn)
-	if _, err := iamc.DeleteRole(client.ctxWithToken, &common.IDOptions{Id: d.Id()}); err != nil {
-		client.log.Error().Err(err).Str("iam-role-id", d.Id()).Msg("Failed to delete IAM Role")
+	if _, err := iamc.DeleteRole(client.apiContext(ctx), &common.IDOptions{Id: d.Id()}); err != nil {
+		tflog.Error(ctx, "Failed to delete IAM Role", map[string]interface{}{"error": err, "iam-role-id": d.Id()})
 		return diag.FromErr(err)
 	}
 	d.SetId("") // called automatically, but added to be explicit
@@ -198,16 +212,19 @@ func resourceIAMRoleDelete(ctx context.Context, d *schema.ResourceData, m interf
 // resourceIAMRoleUpdate will take a resource diff and apply changes accordingly if there are any.
 func resourceIAMRoleUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	client := m.(*Client)
-	if err := client.Connect(); err != nil {
-		client.log.Error().Err(err).Msg("Failed to connect to api")
+	if err := client.Connect(ctx); err != nil {
+		tflog.Error(ctx, "Failed to connect to api", map[string]interface{}{"error": err})
 		return diag.FromErr(err)
 	}
 
 	iamc := iam.NewIAMServiceClient(client.conn)
-	iamRole, err := iamc.GetRole(client.ctxWithToken, &common.IDOptions{Id: d.Id()})
+	iamRole, err := iamc.GetRole(client.apiContext(ctx), &common.IDOptions{Id: d.Id()})
+	if isRemoved(iamRole, err) {
+		tflog.Warn(ctx, "IAM role has been deleted, it is removed from state by the next refresh", map[string]interface{}{"iam-role-id": d.Id()})
+		return diag.Errorf("IAM role %s has been deleted", d.Id())
+	}
 	if err != nil {
-		client.log.Error().Err(err).Msg("Failed to get IAM Role")
-		d.SetId("")
+		tflog.Error(ctx, "Failed to get IAM Role", map[string]interface{}{"error": err, "iam-role-id": d.Id()})
 		return diag.FromErr(err)
 	}
 	// Main fields
@@ -228,9 +245,9 @@ func resourceIAMRoleUpdate(ctx context.Context, d *schema.ResourceData, m interf
 		iamRole.Permissions = permissions
 	}
 
-	res, err := iamc.UpdateRole(client.ctxWithToken, iamRole)
+	res, err := iamc.UpdateRole(client.apiContext(ctx), iamRole)
 	if err != nil {
-		client.log.Error().Err(err).Msg("Failed to update IAM Role")
+		tflog.Error(ctx, "Failed to update IAM Role", map[string]interface{}{"error": err})
 		return diag.FromErr(err)
 	} else {
 		d.SetId(res.GetId())
@@ -21,11 +21,13 @@
 package provider
 
 import (
+	"context"
 	"fmt"
 	"os"
 	"regexp"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -67,15 +69,21 @@ func TestAccResourceIAMRole(t *testing.T) {
 					resource.TestCheckResourceAttr("oasis_iam_role.oasis_iam_role_test", iamRolePermissionsFieldName+".0", "backup.backup.list"),
 				),
 			},
+			{
+				ResourceName:      "oasis_iam_role.oasis_iam_role_test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
 
 // testAccCheckDestroyIAMRole verifies the Terraform oasis_iam_role resource cleanup.
 func testAccCheckDestroyIAMRole(s *terraform.State) error {
+	ctx := context.Background()
 	client := testAccProvider.Meta().(*Client)
-	if err := client.Connect(); err != nil {
-		client.log.Error().Err(err).Msg("Failed to connect to api")
+	if err := client.Connect(ctx); err != nil {
+		tflog.Error(ctx, "Failed to connect to api", map[string]interface{}{"error": err})
 		return err
 	}
 	iamc := iam.NewIAMServiceClient(client.conn)
@@ -85,7 +93,7 @@ func testAccCheckDestroyIAMRole(s *terraform.State) error {
 			continue
 		}
 
-		if _, err := iamc.GetRole(client.ctxWithToken, &common.IDOptions{Id: rs.Primary.ID}); err == nil {
+		if _, err := iamc.GetRole(client.apiContext(ctx), &common.IDOptions{Id: rs.Primary.ID}); err == nil {
 			return fmt.Errorf("iam role still present")
 		}
 	}
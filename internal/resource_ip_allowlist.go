@@ -25,6 +25,7 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
@@ -53,6 +54,15 @@ func resourceIPAllowlist() *schema.Resource {
 		ReadContext:   resourceIPAllowlistRead,
 		UpdateContext: resourceIPAllowlistUpdate,
 		DeleteContext: resourceIPAllowlistDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(shortOperationTimeout),
+			Read:   schema.DefaultTimeout(readOperationTimeout),
+			Update: schema.DefaultTimeout(shortOperationTimeout),
+			Delete: schema.DefaultTimeout(shortOperationTimeout),
+		},
 
 		Schema: map[string]*schema.Schema{
 			ipNameFieldName: {
@@ -65,6 +75,7 @@ func resourceIPAllowlist() *schema.Resource {
 				Type:        schema.TypeString,
 				Description: "IP Allowlist Resource IP Allowlist Project field",
 				Optional:    true,
+				Computed:    true,
 			},
 
 			ipDescriptionFieldName: {
@@ -111,8 +122,8 @@ func resourceIPAllowlist() *schema.Resource {
 // sets the ID of a given IPAllowlist once the creation is successful. This will be stored in local terraform store.
 func resourceIPAllowlistCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	client := m.(*Client)
-	if err := client.Connect(); err != nil {
-		client.log.Error().Err(err).Msg("Failed to connect to api")
+	if err := client.Connect(ctx); err != nil {
+		tflog.Error(ctx, "Failed to connect to api", map[string]interface{}{"error": err})
 		return diag.FromErr(err)
 	}
 	securityc := security.NewSecurityServiceClient(client.conn)
@@ -120,9 +131,9 @@ func resourceIPAllowlistCreate(ctx context.Context, d *schema.ResourceData, m in
 	if err != nil {
 		return diag.FromErr(err)
 	}
-	result, err := securityc.CreateIPAllowlist(client.ctxWithToken, expanded)
+	result, err := securityc.CreateIPAllowlist(client.apiContext(ctx), expanded)
 	if err != nil {
-		client.log.Error().Err(err).Msg("Failed to create ip allowlist")
+		tflog.Error(ctx, "Failed to create ip allowlist", map[string]interface{}{"error": err})
 		return diag.FromErr(err)
 	}
 	if result != nil {
@@ -199,28 +210,26 @@ func flattenIPAllowlistResource(ip *security.IPAllowlist) map[string]interface{}
 // resourceIPAllowlistRead handles the read lifecycle of the IPAllowlist resource.
 func resourceIPAllowlistRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	client := m.(*Client)
-	if err := client.Connect(); err != nil {
-		client.log.Error().Err(err).Msg("Failed to connect to api")
+	if err := client.Connect(ctx); err != nil {
+		tflog.Error(ctx, "Failed to connect to api", map[string]interface{}{"error": err})
 		return diag.FromErr(err)
 	}
 
 	securityc := security.NewSecurityServiceClient(client.conn)
-	ipAllowlist, err := securityc.GetIPAllowlist(client.ctxWithToken, &common.IDOptions{Id: d.Id()})
-	if err != nil {
-		client.log.Error().Err(err).Str("ipallowlist-id", d.Id()).Msg("Failed to find ip allowlist")
-		return diag.FromErr(err)
-	}
-	if ipAllowlist == nil {
-		client.log.Error().Str("ipallowlist-id", d.Id()).Msg("Failed to find ip allowlist")
+	ipAllowlist, err := securityc.GetIPAllowlist(client.apiContext(ctx), &common.IDOptions{Id: d.Id()})
+	if isRemoved(ipAllowlist, err) {
+		tflog.Warn(ctx, "Ip allowlist has been deleted, removing it from state", map[string]interface{}{"ipallowlist-id": d.Id()})
 		d.SetId("")
 		return nil
 	}
+	if err != nil {
+		tflog.Error(ctx, "Failed to find ip allowlist", map[string]interface{}{"error": err, "ipallowlist-id": d.Id()})
+		return diag.FromErr(err)
+	}
 
 	for k, v := range flattenIPAllowlistResource(ipAllowlist) {
-		if _, ok := d.GetOk(k); ok {
-			if err := d.Set(k, v); err != nil {
-				return diag.FromErr(err)
-			}
+		if err := d.Set(k, v); err != nil {
+			return diag.FromErr(err)
 		}
 	}
 	return nil
@@ -229,14 +238,14 @@ func resourceIPAllowlistRead(ctx context.Context, d *schema.ResourceData, m inte
 // resourceIPAllowlistDelete will be called once the resource is destroyed.
 func resourceIPAllowlistDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	client := m.(*Client)
-	if err := client.Connect(); err != nil {
-		client.log.Error().Err(err).Msg("Failed to connect to api")
+	if err := client.Connect(ctx); err != nil {
+		tflog.Error(ctx, "Failed to connect to api", map[string]interface{}{"error": err})
 		return diag.FromErr(err)
 	}
 
 	securityc := security.NewSecurityServiceClient(client.conn)
-	if _, err := securityc.DeleteIPAllowlist(client.ctxWithToken, &common.IDOptions{Id: d.Id()}); err != nil {
-		client.log.Error().Err(err).Str("ipallowlist-id", d.Id()).Msg("Failed to delete ip allowlist")
+	if _, err := securityc.DeleteIPAllowlist(client.apiContext(ctx), &common.IDOptions{Id: d.Id()}); err != nil {
+		tflog.Error(ctx, "Failed to delete ip allowlist", map[string]interface{}{"error": err, "ipallowlist-id": d.Id()})
 		return diag.FromErr(err)
 	}
 	d.SetId("") // called automatically, but added to be explicit
@@ -246,22 +255,21 @@ func resourceIPAllowlistDelete(ctx context.Context, d *schema.ResourceData, m in
 // resourceIPAllowlistUpdate handles the update lifecycle of the IPAllowlist resource.
 func resourceIPAllowlistUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	client := m.(*Client)
-	if err := client.Connect(); err != nil {
-		client.log.Error().Err(err).Msg("Failed to connect to api")
+	if err := client.Connect(ctx); err != nil {
+		tflog.Error(ctx, "Failed to connect to api", map[string]interface{}{"error": err})
 		return diag.FromErr(err)
 	}
 
 	securityc := security.NewSecurityServiceClient(client.conn)
-	ipAllowlist, err := securityc.GetIPAllowlist(client.ctxWithToken, &common.IDOptions{Id: d.Id()})
+	ipAllowlist, err := securityc.GetIPAllowlist(client.apiContext(ctx), &common.IDOptions{Id: d.Id()})
+	if isRemoved(ipAllowlist, err) {
+		tflog.Warn(ctx, "IP allowlist has been deleted, it is removed from state by the next refresh", map[string]interface{}{"ipallowlist-id": d.Id()})
+		return diag.Errorf("IP allowlist %s has been deleted", d.Id())
+	}
 	if err != nil {
-		client.log.Error().Err(err).Str("ipallowlist-id", d.Id()).Msg("Failed get ip allowlist")
+		tflog.Error(ctx, "Failed get ip allowlist", map[string]interface{}{"error": err, "ipallowlist-id": d.Id()})
 		return diag.FromErr(err)
 	}
-	if ipAllowlist == nil {
-		client.log.Error().Str("ipallowlist-id", d.Id()).Msg("Failed to find certificate")
-		d.SetId("")
-		return nil
-	}
 
 	if d.HasChange(ipNameFieldName) {
 		ipAllowlist.Name = d.Get(ipNameFieldName).(string)
@@ -282,9 +290,9 @@ func resourceIPAllowlistUpdate(ctx context.Context, d *schema.ResourceData, m in
 	if d.HasChange(ipLockedFieldName) {
 		ipAllowlist.Locked = d.Get(ipLockedFieldName).(bool)
 	}
-	res, err := securityc.UpdateIPAllowlist(client.ctxWithToken, ipAllowlist)
+	res, err := securityc.UpdateIPAllowlist(client.apiContext(ctx), ipAllowlist)
 	if err != nil {
-		client.log.Error().Err(err).Str("ipallowlist-id", d.Id()).Msg("Failed to update ip allowlist")
+		tflog.Error(ctx, "Failed to update ip allowlist", map[string]interface{}{"error": err, "ipallowlist-id": d.Id()})
 		return diag.FromErr(err)
 	}
 	d.SetId(res.Id)
@@ -29,6 +29,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -76,6 +77,11 @@ func TestResourceIPAllowlist(t *testing.T) {
 					resource.TestCheckResourceAttr("oasis_ipallowlist."+res, ipNameFieldName, name),
 				),
 			},
+			{
+				ResourceName:      "oasis_ipallowlist." + res,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
@@ -156,9 +162,10 @@ func TestExpandingIPAllowlistResourceNameNotDefinedError(t *testing.T) {
 }
 
 func testAccCheckDestroyIPAllowlist(s *terraform.State) error {
+	ctx := context.Background()
 	client := testAccProvider.Meta().(*Client)
-	if err := client.Connect(); err != nil {
-		client.log.Error().Err(err).Msg("Failed to connect to api")
+	if err := client.Connect(ctx); err != nil {
+		tflog.Error(ctx, "Failed to connect to api", map[string]interface{}{"error": err})
 		return err
 	}
 	securityc := security.NewSecurityServiceClient(client.conn)
@@ -168,7 +175,7 @@ func testAccCheckDestroyIPAllowlist(s *terraform.State) error {
 			continue
 		}
 
-		if _, err := securityc.GetIPAllowlist(client.ctxWithToken, &common.IDOptions{Id: rs.Primary.ID}); !common.IsNotFound(err) {
+		if _, err := securityc.GetIPAllowlist(client.apiContext(ctx), &common.IDOptions{Id: rs.Primary.ID}); !common.IsNotFound(err) {
 			return fmt.Errorf("IPAllowlist still present")
 		}
 	}
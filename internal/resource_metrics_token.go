@@ -0,0 +1,287 @@
+//
+// DISCLAIMER
+//
+// Copyright 2024 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package provider
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	common "github.com/arangodb-managed/apis/common/v1"
+	metrics "github.com/arangodb-managed/apis/metrics/v1"
+)
+
+const (
+	// Metrics Token field names
+	metricsTokenNameFieldName               = "name"
+	metricsTokenDescriptionFieldName        = "description"
+	metricsTokenDeploymentFieldName         = "deployment"
+	metricsTokenLifetimeFieldName           = "lifetime"
+	metricsTokenTokenFieldName              = "token"
+	metricsTokenCreatedAtFieldName          = "created_at"
+	metricsTokenExpiresAtFieldName          = "expires_at"
+	metricsTokenIsExpiredFieldName          = "is_expired"
+	metricsTokenWillExpireSoonFieldName     = "will_expire_soon"
+	metricsTokenEndpointFieldName           = "endpoint"
+	metricsTokenEndpointDefaultFieldName    = "endpoint_default"
+	metricsTokenEndpointSelfSignedFieldName = "endpoint_self_signed"
+)
+
+// resourceMetricsToken defines a Metrics Token Oasis resource.
+func resourceMetricsToken() *schema.Resource {
+	return &schema.Resource{
+		Description: "Oasis Metrics Token Resource",
+
+		CreateContext: resourceMetricsTokenCreate,
+		ReadContext:   resourceMetricsTokenRead,
+		UpdateContext: resourceMetricsTokenUpdate,
+		DeleteContext: resourceMetricsTokenDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(defaultOperationTimeout),
+			Read:   schema.DefaultTimeout(readOperationTimeout),
+			Update: schema.DefaultTimeout(defaultOperationTimeout),
+			Delete: schema.DefaultTimeout(defaultOperationTimeout),
+		},
+		Schema: map[string]*schema.Schema{
+			metricsTokenNameFieldName: {
+				Type:        schema.TypeString,
+				Description: "Metrics Token Resource Metrics Token Name field",
+				Required:    true,
+			},
+			metricsTokenDescriptionFieldName: {
+				Type:        schema.TypeString,
+				Description: "Metrics Token Resource Metrics Token Description field",
+				Optional:    true,
+			},
+			metricsTokenDeploymentFieldName: {
+				Type:        schema.TypeString,
+				Description: "Metrics Token Resource Metrics Token Deployment ID field",
+				Required:    true,
+				ForceNew:    true,
+			},
+			metricsTokenLifetimeFieldName: {
+				Type:        schema.TypeInt,
+				Description: "Metrics Token Resource Metrics Token Lifetime field (in seconds, defaults to 90 days)",
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+			},
+			metricsTokenTokenFieldName: {
+				Type:        schema.TypeString,
+				Description: "Metrics Token Resource Metrics Token Token field (bearer token of the metrics endpoint, only known to the state which created the token)",
+				Computed:    true,
+				Sensitive:   true,
+			},
+			metricsTokenCreatedAtFieldName: {
+				Type:        schema.TypeString,
+				Description: "Metrics Token Resource Metrics Token Created At field",
+				Computed:    true,
+			},
+			metricsTokenExpiresAtFieldName: {
+				Type:        schema.TypeString,
+				Description: "Metrics Token Resource Metrics Token Expires At field",
+				Computed:    true,
+			},
+			metricsTokenIsExpiredFieldName: {
+				Type:        schema.TypeBool,
+				Description: "Metrics Token Resource Metrics Token Is Expired field",
+				Computed:    true,
+			},
+			metricsTokenWillExpireSoonFieldName: {
+				Type:        schema.TypeBool,
+				Description: "Metrics Token Resource Metrics Token Will Expire Soon field (set when the token expires in the next month)",
+				Computed:    true,
+			},
+			metricsTokenEndpointFieldName: {
+				Type:        schema.TypeString,
+				Description: "Metrics Token Resource Metrics Endpoint field (URL to scrape the metrics of the deployment from)",
+				Computed:    true,
+			},
+			metricsTokenEndpointDefaultFieldName: {
+				Type:        schema.TypeString,
+				Description: "Metrics Token Resource Metrics Endpoint Default field (URL of port 443, recommended for human-to-metrics-server connections)",
+				Computed:    true,
+			},
+			metricsTokenEndpointSelfSignedFieldName: {
+				Type:        schema.TypeString,
+				Description: "Metrics Token Resource Metrics Endpoint Self Signed field (URL of port 18829 using the self-signed certificate)",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// resourceMetricsTokenCreate handles the creation lifecycle of the metrics token resource.
+// The bearer token is only returned on creation, so it is stored in the state here and kept by all reads.
+func resourceMetricsTokenCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+	if err := client.Connect(ctx); err != nil {
+		tflog.Error(ctx, "Failed to connect to api", map[string]interface{}{"error": err})
+		return diag.FromErr(err)
+	}
+
+	metricsc := metrics.NewMetricsServiceClient(client.conn)
+	result, err := metricsc.CreateToken(client.apiContext(ctx), expandMetricsTokenResource(d))
+	if err != nil {
+		tflog.Error(ctx, "Failed to create metrics token", map[string]interface{}{"error": err, "deployment-id": d.Get(metricsTokenDeploymentFieldName)})
+		return diag.FromErr(err)
+	}
+	client.registerSecret(result.GetToken())
+	d.SetId(result.GetId())
+	if err := d.Set(metricsTokenTokenFieldName, result.GetToken()); err != nil {
+		return diag.FromErr(err)
+	}
+	return resourceMetricsTokenRead(ctx, d, m)
+}
+
+// resourceMetricsTokenRead handles storing and showing data about the metrics token and the metrics endpoint of its deployment.
+// A revoked token can no longer be used, so it is removed from the state like a deleted one.
+func resourceMetricsTokenRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+	if err := client.Connect(ctx); err != nil {
+		tflog.Error(ctx, "Failed to connect to api", map[string]interface{}{"error": err})
+		return diag.FromErr(err)
+	}
+
+	metricsc := metrics.NewMetricsServiceClient(client.conn)
+	token, err := metricsc.GetToken(client.apiContext(ctx), &common.IDOptions{Id: d.Id()})
+	if isRemoved(token, err) || (err == nil && token.GetIsRevoked()) {
+		tflog.Warn(ctx, "Metrics token has been deleted or revoked, removing it from state", map[string]interface{}{"metrics-token-id": d.Id()})
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		tflog.Error(ctx, "Failed to find metrics token", map[string]interface{}{"error": err, "metrics-token-id": d.Id()})
+		return diag.FromErr(err)
+	}
+	endpoint, err := metricsc.GetMetricsEndpoint(client.apiContext(ctx), &metrics.GetMetricsEndpointRequest{DeploymentId: token.GetDeploymentId()})
+	if err != nil {
+		tflog.Error(ctx, "Failed to get metrics endpoint", map[string]interface{}{"error": err, "deployment-id": token.GetDeploymentId()})
+		return diag.FromErr(err)
+	}
+
+	for k, v := range flattenMetricsTokenResource(token, endpoint) {
+		if err := d.Set(k, v); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	return nil
+}
+
+// flattenMetricsTokenResource flattens the metrics token and the metrics endpoint of its deployment into a map for easy storage.
+// The bearer token is not included, since it is only returned on creation.
+func flattenMetricsTokenResource(token *metrics.Token, endpoint *metrics.MetricsEndpoint) map[string]interface{} {
+	return map[string]interface{}{
+		metricsTokenNameFieldName:               token.GetName(),
+		metricsTokenDescriptionFieldName:        token.GetDescription(),
+		metricsTokenDeploymentFieldName:         token.GetDeploymentId(),
+		metricsTokenLifetimeFieldName:           int(token.GetLifetime().GetSeconds()),
+		metricsTokenCreatedAtFieldName:          token.GetCreatedAt().AsTime().Format(time.RFC3339Nano),
+		metricsTokenExpiresAtFieldName:          token.GetExpiresAt().AsTime().Format(time.RFC3339Nano),
+		metricsTokenIsExpiredFieldName:          token.GetIsExpired(),
+		metricsTokenWillExpireSoonFieldName:     token.GetWillExpireSoon(),
+		metricsTokenEndpointFieldName:           endpoint.GetEndpoint(),
+		metricsTokenEndpointDefaultFieldName:    endpoint.GetEndpointDefault(),
+		metricsTokenEndpointSelfSignedFieldName: endpoint.GetEndpointSelfSigned(),
+	}
+}
+
+// expandMetricsTokenResource creates a metrics token from resource data.
+func expandMetricsTokenResource(d *schema.ResourceData) *metrics.Token {
+	token := &metrics.Token{
+		Name:         d.Get(metricsTokenNameFieldName).(string),
+		DeploymentId: d.Get(metricsTokenDeploymentFieldName).(string),
+	}
+	if v, ok := d.GetOk(metricsTokenDescriptionFieldName); ok {
+		token.Description = v.(string)
+	}
+	if v, ok := d.GetOk(metricsTokenLifetimeFieldName); ok && v.(int) > 0 {
+		token.Lifetime = durationpb.New(time.Duration(v.(int)) * time.Second)
+	}
+	return token
+}
+
+// resourceMetricsTokenUpdate handles changes of the name and description of the metrics token.
+// All other fields cannot be changed after creation.
+func resourceMetricsTokenUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+	if err := client.Connect(ctx); err != nil {
+		tflog.Error(ctx, "Failed to connect to api", map[string]interface{}{"error": err})
+		return diag.FromErr(err)
+	}
+
+	metricsc := metrics.NewMetricsServiceClient(client.conn)
+	token, err := metricsc.GetToken(client.apiContext(ctx), &common.IDOptions{Id: d.Id()})
+	if err != nil {
+		tflog.Error(ctx, "Failed to find metrics token", map[string]interface{}{"error": err, "metrics-token-id": d.Id()})
+		return diag.FromErr(err)
+	}
+	if d.HasChange(metricsTokenNameFieldName) {
+		token.Name = d.Get(metricsTokenNameFieldName).(string)
+	}
+	if d.HasChange(metricsTokenDescriptionFieldName) {
+		token.Description = d.Get(metricsTokenDescriptionFieldName).(string)
+	}
+	if _, err := metricsc.UpdateToken(client.apiContext(ctx), token); err != nil {
+		tflog.Error(ctx, "Failed to update metrics token", map[string]interface{}{"error": err, "metrics-token-id": d.Id()})
+		return diag.FromErr(err)
+	}
+	return resourceMetricsTokenRead(ctx, d, m)
+}
+
+// resourceMetricsTokenDelete revokes the metrics token, so it can no longer be used, and deletes it.
+func resourceMetricsTokenDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+	if err := client.Connect(ctx); err != nil {
+		tflog.Error(ctx, "Failed to connect to api", map[string]interface{}{"error": err})
+		return diag.FromErr(err)
+	}
+
+	metricsc := metrics.NewMetricsServiceClient(client.conn)
+	token, err := metricsc.GetToken(client.apiContext(ctx), &common.IDOptions{Id: d.Id()})
+	if isRemoved(token, err) {
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		tflog.Error(ctx, "Failed to find metrics token", map[string]interface{}{"error": err, "metrics-token-id": d.Id()})
+		return diag.FromErr(err)
+	}
+	if !token.GetIsRevoked() {
+		if _, err := metricsc.RevokeToken(client.apiContext(ctx), &common.IDOptions{Id: d.Id()}); err != nil {
+			tflog.Error(ctx, "Failed to revoke metrics token", map[string]interface{}{"error": err, "metrics-token-id": d.Id()})
+			return diag.FromErr(err)
+		}
+	}
+	if _, err := metricsc.DeleteToken(client.apiContext(ctx), &common.IDOptions{Id: d.Id()}); err != nil {
+		tflog.Error(ctx, "Failed to delete metrics token", map[string]interface{}{"error": err, "metrics-token-id": d.Id()})
+		return diag.FromErr(err)
+	}
+	d.SetId("") // called automatically, but added to be explicit
+	return nil
+}
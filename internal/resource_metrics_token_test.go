@@ -0,0 +1,193 @@
+//
+// DISCLAIMER
+//
+// Copyright 2024 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	common "github.com/arangodb-managed/apis/common/v1"
+	metrics "github.com/arangodb-managed/apis/metrics/v1"
+)
+
+// TestAccResourceMetricsToken verifies the Oasis Metrics Token resource is created along with the specified properties.
+func TestAccResourceMetricsToken(t *testing.T) {
+	if _, ok := os.LookupEnv("TF_ACC"); !ok {
+		t.Skip()
+	}
+	t.Parallel()
+
+	orgID, err := FetchOrganizationID()
+	require.NoError(t, err)
+	projID, err := FetchProjectID(context.Background(), orgID, testAccProvider)
+	require.NoError(t, err)
+
+	name := "terraform-metrics-token-" + acctest.RandString(5)
+	resourceName := "oasis_metrics_token.oasis_metrics_token_test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testProviderFactories,
+		CheckDestroy:      testAccCheckDestroyMetricsToken,
+		Steps: []resource.TestStep{
+			{
+				Config: testMetricsTokenConfig(projID, name, "Terraform generated metrics token"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, metricsTokenNameFieldName, name),
+					resource.TestCheckResourceAttr(resourceName, metricsTokenDescriptionFieldName, "Terraform generated metrics token"),
+					resource.TestCheckResourceAttr(resourceName, metricsTokenLifetimeFieldName, "604800"),
+					resource.TestCheckResourceAttrSet(resourceName, metricsTokenTokenFieldName),
+					resource.TestCheckResourceAttrSet(resourceName, metricsTokenExpiresAtFieldName),
+					resource.TestCheckResourceAttrSet(resourceName, metricsTokenEndpointFieldName),
+				),
+			},
+			{
+				Config: testMetricsTokenConfig(projID, name, "Terraform updated metrics token"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, metricsTokenDescriptionFieldName, "Terraform updated metrics token"),
+					resource.TestCheckResourceAttrSet(resourceName, metricsTokenTokenFieldName),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{metricsTokenTokenFieldName},
+			},
+		},
+	})
+}
+
+// testAccCheckDestroyMetricsToken verifies the Terraform oasis_metrics_token resource cleanup.
+func testAccCheckDestroyMetricsToken(s *terraform.State) error {
+	ctx := context.Background()
+	client := testAccProvider.Meta().(*Client)
+	if err := client.Connect(ctx); err != nil {
+		tflog.Error(ctx, "Failed to connect to api", map[string]interface{}{"error": err})
+		return err
+	}
+	metricsc := metrics.NewMetricsServiceClient(client.conn)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "oasis_metrics_token" {
+			continue
+		}
+
+		if token, err := metricsc.GetToken(client.apiContext(ctx), &common.IDOptions{Id: rs.Primary.ID}); err == nil && !token.GetIsRevoked() {
+			return fmt.Errorf("metrics token still present")
+		}
+	}
+
+	return nil
+}
+
+// TestFlattenMetricsToken tests the Oasis Metrics Token flattening for Terraform schema compatibility.
+func TestFlattenMetricsToken(t *testing.T) {
+	token := &metrics.Token{
+		Id:             "token-id",
+		Name:           "test-token",
+		Description:    "test-description",
+		DeploymentId:   "deployment-id",
+		Lifetime:       durationpb.New(24 * time.Hour),
+		CreatedAt:      timestamppb.New(time.Date(1980, 03, 03, 1, 1, 1, 0, time.UTC)),
+		ExpiresAt:      timestamppb.New(time.Date(1980, 03, 04, 1, 1, 1, 0, time.UTC)),
+		Token:          "secret-token",
+		WillExpireSoon: true,
+	}
+	endpoint := &metrics.MetricsEndpoint{
+		Endpoint:           "https://a1b2c3d4.arangodb.cloud:8829/metrics",
+		EndpointSelfSigned: "https://a1b2c3d4.arangodb.cloud:18829/metrics",
+		EndpointDefault:    "https://a1b2c3d4.arangodb.cloud/metrics",
+	}
+	expected := map[string]interface{}{
+		metricsTokenNameFieldName:               "test-token",
+		metricsTokenDescriptionFieldName:        "test-description",
+		metricsTokenDeploymentFieldName:         "deployment-id",
+		metricsTokenLifetimeFieldName:           86400,
+		metricsTokenCreatedAtFieldName:          "1980-03-03T01:01:01Z",
+		metricsTokenExpiresAtFieldName:          "1980-03-04T01:01:01Z",
+		metricsTokenIsExpiredFieldName:          false,
+		metricsTokenWillExpireSoonFieldName:     true,
+		metricsTokenEndpointFieldName:           "https://a1b2c3d4.arangodb.cloud:8829/metrics",
+		metricsTokenEndpointDefaultFieldName:    "https://a1b2c3d4.arangodb.cloud/metrics",
+		metricsTokenEndpointSelfSignedFieldName: "https://a1b2c3d4.arangodb.cloud:18829/metrics",
+	}
+	assert.Equal(t, expected, flattenMetricsTokenResource(token, endpoint))
+}
+
+// TestExpandMetricsToken tests the Oasis Metrics Token expansion for Terraform schema compatibility.
+func TestExpandMetricsToken(t *testing.T) {
+	raw := map[string]interface{}{
+		metricsTokenNameFieldName:        "test-token",
+		metricsTokenDescriptionFieldName: "test-description",
+		metricsTokenDeploymentFieldName:  "deployment-id",
+		metricsTokenLifetimeFieldName:    3600,
+	}
+	s := resourceMetricsToken().Schema
+	data := schema.TestResourceDataRaw(t, s, raw)
+	token := expandMetricsTokenResource(data)
+	assert.Equal(t, "test-token", token.GetName())
+	assert.Equal(t, "test-description", token.GetDescription())
+	assert.Equal(t, "deployment-id", token.GetDeploymentId())
+	assert.Equal(t, time.Hour, token.GetLifetime().AsDuration())
+
+	delete(raw, metricsTokenLifetimeFieldName)
+	data = schema.TestResourceDataRaw(t, s, raw)
+	assert.Nil(t, expandMetricsTokenResource(data).GetLifetime())
+}
+
+// testMetricsTokenConfig contains the Terraform resource definitions for testing usage
+func testMetricsTokenConfig(projID, name, description string) string {
+	return fmt.Sprintf(`resource "oasis_deployment" "my_oneshard_deployment" {
+  terms_and_conditions_accepted = "true"
+  name        = "oasis_test_deployment_terraform"
+  description = "Terraform Generated Deployment"
+  project     = "%s"
+  location {
+    region = "gcp-europe-west4"
+  }
+  configuration {
+    model      = "oneshard"
+    node_count = 3
+  }
+}
+
+resource "oasis_metrics_token" "oasis_metrics_token_test" {
+  name        = "%s"
+  description = "%s"
+  deployment  = oasis_deployment.my_oneshard_deployment.id
+  lifetime    = 604800
+}
+`, projID, name, description)
+}
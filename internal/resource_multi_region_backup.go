@@ -25,10 +25,12 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	backup "github.com/arangodb-managed/apis/backup/v1"
+	common "github.com/arangodb-managed/apis/common/v1"
 )
 
 const (
@@ -42,9 +44,18 @@ func resourceMultiRegionBackup() *schema.Resource {
 	return &schema.Resource{
 		Description:   "Oasis Multi Region Backup Resource",
 		CreateContext: resourceMultiRegionBackupCreate,
-		ReadContext:   resourceBackupRead,
+		ReadContext:   resourceMultiRegionBackupRead,
 		UpdateContext: resourceBackupUpdate,
 		DeleteContext: resourceBackupDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(longOperationTimeout),
+			Read:   schema.DefaultTimeout(readOperationTimeout),
+			Update: schema.DefaultTimeout(longOperationTimeout),
+			Delete: schema.DefaultTimeout(longOperationTimeout),
+		},
 		Schema: map[string]*schema.Schema{
 			backupSourceBackupIDFieldName: {
 				Type:        schema.TypeString,
@@ -102,8 +113,8 @@ func resourceMultiRegionBackup() *schema.Resource {
 // to initiate a copy procedure for a given backup and region identifier.
 func resourceMultiRegionBackupCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	client := m.(*Client)
-	if err := client.Connect(); err != nil {
-		client.log.Error().Err(err).Msg("Failed to connect to api")
+	if err := client.Connect(ctx); err != nil {
+		tflog.Error(ctx, "Failed to connect to api", map[string]interface{}{"error": err})
 		return diag.FromErr(err)
 	}
 
@@ -112,28 +123,28 @@ func resourceMultiRegionBackupCreate(ctx context.Context, d *schema.ResourceData
 		req.SourceBackupId = v.(string)
 	} else {
 		err := fmt.Errorf("unable to find parse field %s", backupSourceBackupIDFieldName)
-		client.log.Error().Err(err).Msg("Source backup identifier required")
+		tflog.Error(ctx, "Source backup identifier required", map[string]interface{}{"error": err})
 		return diag.FromErr(err)
 	}
 	if v, ok := d.GetOk(backupRegionIDFieldName); ok && strings.TrimSpace(v.(string)) != "" {
 		req.RegionId = v.(string)
 	} else {
 		err := fmt.Errorf("unable to find parse field %s", backupRegionIDFieldName)
-		client.log.Error().Err(err).Msg("Region identifier required")
+		tflog.Error(ctx, "Region identifier required", map[string]interface{}{"error": err})
 		return diag.FromErr(err)
 	}
 
 	backupc := backup.NewBackupServiceClient(client.conn)
 
-	backup, err := backupc.CopyBackup(client.ctxWithToken, req)
+	backup, err := backupc.CopyBackup(client.apiContext(ctx), req)
 	if err != nil {
-		client.log.Error().Err(err).Msg("Failed to create backup")
+		tflog.Error(ctx, "Failed to create backup", map[string]interface{}{"error": err})
 		return diag.FromErr(err)
 	} else {
 		d.SetId(backup.GetId())
 	}
 
-	for k, v := range flattenBackupResource(backup) {
+	for k, v := range flattenMultiRegionBackupResource(backup) {
 		if err := d.Set(k, v); err != nil {
 			return diag.FromErr(err)
 		}
@@ -141,3 +152,38 @@ func resourceMultiRegionBackupCreate(ctx context.Context, d *schema.ResourceData
 
 	return nil
 }
+
+// resourceMultiRegionBackupRead will gather information from the Terraform store and display it accordingly.
+func resourceMultiRegionBackupRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+	if err := client.Connect(ctx); err != nil {
+		tflog.Error(ctx, "Failed to connect to api", map[string]interface{}{"error": err})
+		return diag.FromErr(err)
+	}
+
+	backupc := backup.NewBackupServiceClient(client.conn)
+	backup, err := backupc.GetBackup(client.apiContext(ctx), &common.IDOptions{Id: d.Id()})
+	if isRemoved(backup, err) {
+		tflog.Warn(ctx, "Backup has been deleted, removing it from state", map[string]interface{}{"backup-id": d.Id()})
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		tflog.Error(ctx, "Failed to find backup", map[string]interface{}{"error": err, "backup-id": d.Id()})
+		return diag.FromErr(err)
+	}
+
+	for k, v := range flattenMultiRegionBackupResource(backup) {
+		if err := d.Set(k, v); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	return nil
+}
+
+// flattenMultiRegionBackupResource will take a copied Backup object and turn it into a flat map for terraform digestion.
+func flattenMultiRegionBackupResource(backup *backup.Backup) map[string]interface{} {
+	result := flattenBackupResource(backup)
+	result[backupSourceBackupIDFieldName] = backup.GetSourceBackupId()
+	return result
+}
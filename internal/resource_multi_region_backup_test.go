@@ -29,6 +29,7 @@ import (
 
 	backup "github.com/arangodb-managed/apis/backup/v1"
 	common "github.com/arangodb-managed/apis/common/v1"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -67,6 +68,12 @@ func TestAccResourceMultiRegionBackup(t *testing.T) {
 					resource.TestCheckResourceAttr("oasis_multi_region_backup."+resourceName, backupRegionIDFieldName, regionID),
 				),
 			},
+			{
+				ResourceName:            "oasis_multi_region_backup." + resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{backupAutoDeleteAtFieldName},
+			},
 		},
 	})
 }
@@ -115,9 +122,10 @@ func testMultiRegionBackupConfig(project, backupResource, regionID string) strin
 
 // testAccCheckDestroyMultiRegionBackup verifies the Terraform oasis_multi_region_backup resource cleanup.
 func testAccCheckDestroyMultiRegionBackup(s *terraform.State) error {
+	ctx := context.Background()
 	client := testAccProvider.Meta().(*Client)
-	if err := client.Connect(); err != nil {
-		client.log.Error().Err(err).Msg("Failed to connect to api")
+	if err := client.Connect(ctx); err != nil {
+		tflog.Error(ctx, "Failed to connect to api", map[string]interface{}{"error": err})
 		return err
 	}
 	backupc := backup.NewBackupServiceClient(client.conn)
@@ -127,7 +135,7 @@ func testAccCheckDestroyMultiRegionBackup(s *terraform.State) error {
 			continue
 		}
 
-		if _, err := backupc.GetBackup(client.ctxWithToken, &common.IDOptions{Id: rs.Primary.ID}); !common.IsNotFound(err) {
+		if _, err := backupc.GetBackup(client.apiContext(ctx), &common.IDOptions{Id: rs.Primary.ID}); !common.IsNotFound(err) {
 			return fmt.Errorf("backup still present")
 		}
 	}
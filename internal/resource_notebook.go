@@ -25,6 +25,7 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
@@ -69,6 +70,15 @@ func resourceNotebook() *schema.Resource {
 		ReadContext:   resourceNotebookRead,
 		UpdateContext: resourceNotebookUpdate,
 		DeleteContext: resourceNotebookDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(longOperationTimeout),
+			Read:   schema.DefaultTimeout(readOperationTimeout),
+			Update: schema.DefaultTimeout(longOperationTimeout),
+			Delete: schema.DefaultTimeout(longOperationTimeout),
+		},
 		Schema: map[string]*schema.Schema{
 			notebookDeploymentIdFieldName: {
 				Type:        schema.TypeString,
@@ -211,16 +221,20 @@ func resourceNotebook() *schema.Resource {
 // resourceNotebookRead will gather information from the Terraform store for Oasis Notebook resource and display it accordingly.
 func resourceNotebookRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	client := m.(*Client)
-	if err := client.Connect(); err != nil {
-		client.log.Error().Err(err).Msg("Failed to connect to api")
+	if err := client.Connect(ctx); err != nil {
+		tflog.Error(ctx, "Failed to connect to api", map[string]interface{}{"error": err})
 		return diag.FromErr(err)
 	}
 
 	nbc := nb.NewNotebookServiceClient(client.conn)
-	notebook, err := nbc.GetNotebook(client.ctxWithToken, &common.IDOptions{Id: d.Id()})
-	if err != nil || notebook == nil {
-		client.log.Error().Err(err).Str("notebook-id", d.Id()).Msg("Failed to find Notebook")
+	notebook, err := nbc.GetNotebook(client.apiContext(ctx), &common.IDOptions{Id: d.Id()})
+	if isRemoved(notebook, err) {
+		tflog.Warn(ctx, "Notebook has been deleted, removing it from state", map[string]interface{}{"notebook-id": d.Id()})
 		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		tflog.Error(ctx, "Failed to find notebook", map[string]interface{}{"error": err, "notebook-id": d.Id()})
 		return diag.FromErr(err)
 	}
 
@@ -312,23 +326,23 @@ func flattenNotebookStatusUsage(notebookStatusUsage *nb.Status_Usage) []interfac
 // in order to create this object.
 func resourceNotebookCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	client := m.(*Client)
-	if err := client.Connect(); err != nil {
-		client.log.Error().Err(err).Msg("Failed to connect to api")
+	if err := client.Connect(ctx); err != nil {
+		tflog.Error(ctx, "Failed to connect to api", map[string]interface{}{"error": err})
 		return diag.FromErr(err)
 	}
 	nbc := nb.NewNotebookServiceClient(client.conn)
 	expanded, err := expandNotebookResource(d)
 	if err != nil {
-		client.log.Error().Err(err).Msg("Failed to connect to api")
+		tflog.Error(ctx, "Failed to connect to api", map[string]interface{}{"error": err})
 		return diag.FromErr(err)
 	}
 
-	client.log.Info().Msg("Before entering Create notebook")
-	result, err := nbc.CreateNotebook(client.ctxWithToken, expanded)
-	client.log.Info().Msg("Creating notebook")
+	tflog.Info(ctx, "Before entering Create notebook")
+	result, err := nbc.CreateNotebook(client.apiContext(ctx), expanded)
+	tflog.Info(ctx, "Creating notebook")
 
 	if err != nil {
-		client.log.Error().Err(err).Msg("Failed to create notebook")
+		tflog.Error(ctx, "Failed to create notebook", map[string]interface{}{"error": err})
 		return diag.FromErr(err)
 	}
 	if result != nil {
@@ -379,14 +393,14 @@ func expandNotebookModel(s []interface{}) *nb.ModelSpec {
 // resourceNotebookDelete will delete a given Notebook resource based on the given ID
 func resourceNotebookDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	client := m.(*Client)
-	if err := client.Connect(); err != nil {
-		client.log.Error().Err(err).Msg("Failed to connect to api")
+	if err := client.Connect(ctx); err != nil {
+		tflog.Error(ctx, "Failed to connect to api", map[string]interface{}{"error": err})
 		return diag.FromErr(err)
 	}
 
 	nbc := nb.NewNotebookServiceClient(client.conn)
-	if _, err := nbc.DeleteNotebook(client.ctxWithToken, &common.IDOptions{Id: d.Id()}); err != nil {
-		client.log.Error().Err(err).Str("notebook-id", d.Id()).Msg("Failed to delete Notebook")
+	if _, err := nbc.DeleteNotebook(client.apiContext(ctx), &common.IDOptions{Id: d.Id()}); err != nil {
+		tflog.Error(ctx, "Failed to delete Notebook", map[string]interface{}{"error": err, "notebook-id": d.Id()})
 		return diag.FromErr(err)
 	}
 	d.SetId("") // called automatically, but added to be explicit
@@ -396,16 +410,19 @@ func resourceNotebookDelete(ctx context.Context, d *schema.ResourceData, m inter
 // resourceNotebookUpdate will take a resource diff and apply changes accordingly if there are any.
 func resourceNotebookUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	client := m.(*Client)
-	if err := client.Connect(); err != nil {
-		client.log.Error().Err(err).Msg("Failed to connect to api")
+	if err := client.Connect(ctx); err != nil {
+		tflog.Error(ctx, "Failed to connect to api", map[string]interface{}{"error": err})
 		return diag.FromErr(err)
 	}
 
 	nbc := nb.NewNotebookServiceClient(client.conn)
-	notebook, err := nbc.GetNotebook(client.ctxWithToken, &common.IDOptions{Id: d.Id()})
+	notebook, err := nbc.GetNotebook(client.apiContext(ctx), &common.IDOptions{Id: d.Id()})
+	if isRemoved(notebook, err) {
+		tflog.Warn(ctx, "Notebook has been deleted, it is removed from state by the next refresh", map[string]interface{}{"notebook-id": d.Id()})
+		return diag.Errorf("notebook %s has been deleted", d.Id())
+	}
 	if err != nil {
-		client.log.Error().Err(err).Msg("Failed to find Notebook")
-		d.SetId("")
+		tflog.Error(ctx, "Failed to find Notebook", map[string]interface{}{"error": err, "notebook-id": d.Id()})
 		return diag.FromErr(err)
 	}
 	// Main fields
@@ -421,9 +438,9 @@ func resourceNotebookUpdate(ctx context.Context, d *schema.ResourceData, m inter
 		notebook.Model = expandNotebookModel(d.Get(notebookModelFieldName).([]interface{}))
 	}
 
-	_, err = nbc.UpdateNotebook(client.ctxWithToken, notebook)
+	_, err = nbc.UpdateNotebook(client.apiContext(ctx), notebook)
 	if err != nil {
-		client.log.Error().Err(err).Msg("Failed to update notebook")
+		tflog.Error(ctx, "Failed to update notebook", map[string]interface{}{"error": err})
 		return diag.FromErr(err)
 	} else {
 		d.SetId(notebook.GetId())
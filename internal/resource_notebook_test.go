@@ -28,6 +28,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -70,6 +71,12 @@ func TestAccResourceNotebook(t *testing.T) {
 					resource.TestCheckResourceAttr("oasis_notebook."+resourceName, notebookNameFieldName, "Test-Notebook"),
 				),
 			},
+			{
+				ResourceName:            "oasis_notebook." + resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{notebookStatusFieldName},
+			},
 		},
 	})
 }
@@ -180,9 +187,10 @@ func TestExpandNotebook(t *testing.T) {
 
 // testAccCheckDestroyNotebook verifies the Terraform oasis_notebook resource cleanup.
 func testAccCheckDestroyNotebook(s *terraform.State) error {
+	ctx := context.Background()
 	client := testAccProvider.Meta().(*Client)
-	if err := client.Connect(); err != nil {
-		client.log.Error().Err(err).Msg("Failed to connect to api")
+	if err := client.Connect(ctx); err != nil {
+		tflog.Error(ctx, "Failed to connect to api", map[string]interface{}{"error": err})
 		return err
 	}
 	nbc := nb.NewNotebookServiceClient(client.conn)
@@ -192,7 +200,7 @@ func testAccCheckDestroyNotebook(s *terraform.State) error {
 			continue
 		}
 
-		if _, err := nbc.GetNotebook(client.ctxWithToken, &common.IDOptions{Id: rs.Primary.ID}); !common.IsNotFound(err) {
+		if _, err := nbc.GetNotebook(client.apiContext(ctx), &common.IDOptions{Id: rs.Primary.ID}); !common.IsNotFound(err) {
 			return fmt.Errorf("notebook still present")
 		}
 	}
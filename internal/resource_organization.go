@@ -24,6 +24,7 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
@@ -53,6 +54,15 @@ func resourceOrganization() *schema.Resource {
 		ReadContext:   resourceOrganizationRead,
 		UpdateContext: resourceOrganizationUpdate,
 		DeleteContext: resourceOrganizationDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(defaultOperationTimeout),
+			Read:   schema.DefaultTimeout(readOperationTimeout),
+			Update: schema.DefaultTimeout(defaultOperationTimeout),
+			Delete: schema.DefaultTimeout(defaultOperationTimeout),
+		},
 		Schema: map[string]*schema.Schema{
 			organizationNameFieldName: {
 				Type:        schema.TypeString,
@@ -117,16 +127,20 @@ func resourceOrganization() *schema.Resource {
 // resourceOrganizationRead will gather information from the Terraform store for Oasis Organization resource and display it accordingly.
 func resourceOrganizationRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	client := m.(*Client)
-	if err := client.Connect(); err != nil {
-		client.log.Error().Err(err).Msg("Failed to connect to api")
+	if err := client.Connect(ctx); err != nil {
+		tflog.Error(ctx, "Failed to connect to api", map[string]interface{}{"error": err})
 		return diag.FromErr(err)
 	}
 
 	rmc := rm.NewResourceManagerServiceClient(client.conn)
-	organization, err := rmc.GetOrganization(client.ctxWithToken, &common.IDOptions{Id: d.Id()})
-	if err != nil || organization == nil {
-		client.log.Error().Err(err).Str("organization-id", d.Id()).Msg("Failed to find Organization")
+	organization, err := rmc.GetOrganization(client.apiContext(ctx), &common.IDOptions{Id: d.Id()})
+	if isRemoved(organization, err) {
+		tflog.Warn(ctx, "Organization has been deleted, removing it from state", map[string]interface{}{"organization-id": d.Id()})
 		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		tflog.Error(ctx, "Failed to find organization", map[string]interface{}{"error": err, "organization-id": d.Id()})
 		return diag.FromErr(err)
 	}
 
@@ -144,8 +158,8 @@ func resourceOrganizationRead(ctx context.Context, d *schema.ResourceData, m int
 // in order to create this object.
 func resourceOrganizationCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	client := m.(*Client)
-	if err := client.Connect(); err != nil {
-		client.log.Error().Err(err).Msg("Failed to connect to api")
+	if err := client.Connect(ctx); err != nil {
+		tflog.Error(ctx, "Failed to connect to api", map[string]interface{}{"error": err})
 		return diag.FromErr(err)
 	}
 	rmc := rm.NewResourceManagerServiceClient(client.conn)
@@ -154,9 +168,9 @@ func resourceOrganizationCreate(ctx context.Context, d *schema.ResourceData, m i
 		return diag.FromErr(err)
 	}
 
-	result, err := rmc.CreateOrganization(client.ctxWithToken, expanded)
+	result, err := rmc.CreateOrganization(client.apiContext(ctx), expanded)
 	if err != nil {
-		client.log.Error().Err(err).Msg("Failed to create organization")
+		tflog.Error(ctx, "Failed to create organization", map[string]interface{}{"error": err})
 		return diag.FromErr(err)
 	}
 	if result != nil {
@@ -188,14 +202,14 @@ func expandOrganizationResource(d *schema.ResourceData) (*rm.Organization, error
 // resourceOrganizationDelete will delete a given Organization resource based on the given ID
 func resourceOrganizationDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	client := m.(*Client)
-	if err := client.Connect(); err != nil {
-		client.log.Error().Err(err).Msg("Failed to connect to api")
+	if err := client.Connect(ctx); err != nil {
+		tflog.Error(ctx, "Failed to connect to api", map[string]interface{}{"error": err})
 		return diag.FromErr(err)
 	}
 
 	rmc := rm.NewResourceManagerServiceClient(client.conn)
-	if _, err := rmc.DeleteOrganization(client.ctxWithToken, &common.IDOptions{Id: d.Id()}); err != nil {
-		client.log.Error().Err(err).Str("organization-id", d.Id()).Msg("Failed to delete Organization")
+	if _, err := rmc.DeleteOrganization(client.apiContext(ctx), &common.IDOptions{Id: d.Id()}); err != nil {
+		tflog.Error(ctx, "Failed to delete Organization", map[string]interface{}{"error": err, "organization-id": d.Id()})
 		return diag.FromErr(err)
 	}
 	d.SetId("") // called automatically, but added to be explicit
@@ -205,16 +219,19 @@ func resourceOrganizationDelete(ctx context.Context, d *schema.ResourceData, m i
 // resourceOrganizationUpdate will take a resource diff and apply changes accordingly if there are any.
 func resourceOrganizationUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	client := m.(*Client)
-	if err := client.Connect(); err != nil {
-		client.log.Error().Err(err).Msg("Failed to connect to api")
+	if err := client.Connect(ctx); err != nil {
+		tflog.Error(ctx, "Failed to connect to api", map[string]interface{}{"error": err})
 		return diag.FromErr(err)
 	}
 
 	rmc := rm.NewResourceManagerServiceClient(client.conn)
-	organization, err := rmc.GetOrganization(client.ctxWithToken, &common.IDOptions{Id: d.Id()})
+	organization, err := rmc.GetOrganization(client.apiContext(ctx), &common.IDOptions{Id: d.Id()})
+	if isRemoved(organization, err) {
+		tflog.Warn(ctx, "Organization has been deleted, it is removed from state by the next refresh", map[string]interface{}{"organization-id": d.Id()})
+		return diag.Errorf("organization %s has been deleted", d.Id())
+	}
 	if err != nil {
-		client.log.Error().Err(err).Msg("Failed to find Organization")
-		d.SetId("")
+		tflog.Error(ctx, "Failed to find Organization", map[string]interface{}{"error": err, "organization-id": d.Id()})
 		return diag.FromErr(err)
 	}
 	// Main fields
@@ -230,9 +247,9 @@ func resourceOrganizationUpdate(ctx context.Context, d *schema.ResourceData, m i
 	if v, ok := d.GetOk(authenticationProvidersFieldName); ok {
 		organization.AuthenticationProviders = expandAuthenticationProviders(v.([]interface{}))
 	}
-	res, err := rmc.UpdateOrganization(client.ctxWithToken, organization)
+	res, err := rmc.UpdateOrganization(client.apiContext(ctx), organization)
 	if err != nil {
-		client.log.Error().Err(err).Msg("Failed to update Organization")
+		tflog.Error(ctx, "Failed to update Organization", map[string]interface{}{"error": err})
 		return diag.FromErr(err)
 	} else {
 		d.SetId(res.GetId())
@@ -23,7 +23,9 @@ package provider
 import (
 	"context"
 	"fmt"
+	"strings"
 
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
@@ -37,6 +39,9 @@ const (
 	organizationInviteOrganizationFieldName = "organization"
 )
 
+// organizationInviteImportPageSize is the number of invites listed at once when importing an invite by email address
+const organizationInviteImportPageSize = 100
+
 // resourceOrganizationInvite defines the Organization Invite Terraform resource Schema.
 func resourceOrganizationInvite() *schema.Resource {
 	return &schema.Resource{
@@ -45,6 +50,14 @@ func resourceOrganizationInvite() *schema.Resource {
 		CreateContext: resourceOrganizationInviteCreate,
 		ReadContext:   resourceOrganizationInviteRead,
 		DeleteContext: resourceOrganizationInviteDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceOrganizationInviteImport,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(defaultOperationTimeout),
+			Read:   schema.DefaultTimeout(readOperationTimeout),
+			Delete: schema.DefaultTimeout(defaultOperationTimeout),
+		},
 
 		Schema: map[string]*schema.Schema{
 			organizationInviteEmailFieldName: {
@@ -68,8 +81,8 @@ func resourceOrganizationInvite() *schema.Resource {
 // sets the ID of a given Organization Invite once the creation is successful. This will be stored in local Terraform store.
 func resourceOrganizationInviteCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	client := m.(*Client)
-	if err := client.Connect(); err != nil {
-		client.log.Error().Err(err).Msg("Failed to connect to api")
+	if err := client.Connect(ctx); err != nil {
+		tflog.Error(ctx, "Failed to connect to api", map[string]interface{}{"error": err})
 		return diag.FromErr(err)
 	}
 	rmc := rm.NewResourceManagerServiceClient(client.conn)
@@ -77,9 +90,9 @@ func resourceOrganizationInviteCreate(ctx context.Context, d *schema.ResourceDat
 	if err != nil {
 		return diag.FromErr(err)
 	}
-	result, err := rmc.CreateOrganizationInvite(client.ctxWithToken, expanded)
+	result, err := rmc.CreateOrganizationInvite(client.apiContext(ctx), expanded)
 	if err != nil {
-		client.log.Error().Err(err).Msg("Failed to create organization invite")
+		tflog.Error(ctx, "Failed to create organization invite", map[string]interface{}{"error": err})
 		return diag.FromErr(err)
 	}
 	if result != nil {
@@ -117,23 +130,22 @@ func flattenOrganizationInviteResource(organizationInvite *rm.OrganizationInvite
 // resourceOrganizationInviteRead handles the read lifecycle of the Organization Invite resource.
 func resourceOrganizationInviteRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	client := m.(*Client)
-	if err := client.Connect(); err != nil {
-		client.log.Error().Err(err).Msg("Failed to connect to api")
+	if err := client.Connect(ctx); err != nil {
+		tflog.Error(ctx, "Failed to connect to api", map[string]interface{}{"error": err})
 		return diag.FromErr(err)
 	}
 
 	rmc := rm.NewResourceManagerServiceClient(client.conn)
-	p, err := rmc.GetOrganizationInvite(client.ctxWithToken, &common.IDOptions{Id: d.Id()})
-	if err != nil {
-		client.log.Error().Err(err).Str("organization-invite-id", d.Id()).Msg("Failed to find organization invite")
-		d.SetId("")
-		return diag.FromErr(err)
-	}
-	if p == nil {
-		client.log.Error().Str("organization-invite-id", d.Id()).Msg("Failed to find organization invite")
+	p, err := rmc.GetOrganizationInvite(client.apiContext(ctx), &common.IDOptions{Id: d.Id()})
+	if common.IsNotFound(err) {
+		tflog.Warn(ctx, "Organization invite has been deleted, removing it from state", map[string]interface{}{"organization-invite-id": d.Id()})
 		d.SetId("")
 		return nil
 	}
+	if err != nil {
+		tflog.Error(ctx, "Failed to find organization invite", map[string]interface{}{"error": err, "organization-invite-id": d.Id()})
+		return diag.FromErr(err)
+	}
 
 	for k, v := range flattenOrganizationInviteResource(p) {
 		if err := d.Set(k, v); err != nil {
@@ -143,17 +155,53 @@ func resourceOrganizationInviteRead(ctx context.Context, d *schema.ResourceData,
 	return nil
 }
 
+// resourceOrganizationInviteImport imports an Organization Invite by its ID, or by the ID of its organization
+// and its email address in the form <organization_id>/<email>.
+func resourceOrganizationInviteImport(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	organizationID, email, ok := strings.Cut(d.Id(), "/")
+	if !ok {
+		return []*schema.ResourceData{d}, nil
+	}
+	if organizationID == "" || email == "" {
+		return nil, fmt.Errorf("invalid import ID %q, expected <organization_id>/<email>", d.Id())
+	}
+
+	client := m.(*Client)
+	if err := client.Connect(ctx); err != nil {
+		tflog.Error(ctx, "Failed to connect to api", map[string]interface{}{"error": err})
+		return nil, err
+	}
+
+	rmc := rm.NewResourceManagerServiceClient(client.conn)
+	for opts := (&common.ListOptions{ContextId: organizationID, PageSize: organizationInviteImportPageSize}); ; opts.Page++ {
+		list, err := rmc.ListOrganizationInvites(client.apiContext(ctx), opts)
+		if err != nil {
+			tflog.Error(ctx, "Failed to list organization invites", map[string]interface{}{"error": err, "organization-id": organizationID})
+			return nil, err
+		}
+		for _, invite := range list.GetItems() {
+			if strings.EqualFold(invite.GetEmail(), email) {
+				d.SetId(invite.GetId())
+				return []*schema.ResourceData{d}, nil
+			}
+		}
+		if len(list.GetItems()) < int(opts.PageSize) {
+			return nil, fmt.Errorf("no invite for %s found in organization %s", email, organizationID)
+		}
+	}
+}
+
 // resourceOrganizationInviteDelete will be called once the resource is destroyed.
 func resourceOrganizationInviteDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	client := m.(*Client)
-	if err := client.Connect(); err != nil {
-		client.log.Error().Err(err).Msg("Failed to connect to api")
+	if err := client.Connect(ctx); err != nil {
+		tflog.Error(ctx, "Failed to connect to api", map[string]interface{}{"error": err})
 		return diag.FromErr(err)
 	}
 
 	rmc := rm.NewResourceManagerServiceClient(client.conn)
-	if _, err := rmc.DeleteOrganizationInvite(client.ctxWithToken, &common.IDOptions{Id: d.Id()}); err != nil {
-		client.log.Error().Err(err).Str("organization-invite-id", d.Id()).Msg("Failed to delete organization invite")
+	if _, err := rmc.DeleteOrganizationInvite(client.apiContext(ctx), &common.IDOptions{Id: d.Id()}); err != nil {
+		tflog.Error(ctx, "Failed to delete organization invite", map[string]interface{}{"error": err, "organization-invite-id": d.Id()})
 		return diag.FromErr(err)
 	}
 	d.SetId("") // called automatically, but added to be explicit
@@ -21,11 +21,13 @@
 package provider
 
 import (
+	"context"
 	"fmt"
 	"os"
 	"regexp"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -65,15 +67,22 @@ func TestAccResourceOrganizationInvite(t *testing.T) {
 					resource.TestCheckResourceAttr("oasis_organization_invite.oasis_organization_invite_test", organizationInviteOrganizationFieldName, orgID),
 				),
 			},
+			{
+				ResourceName:      "oasis_organization_invite.oasis_organization_invite_test",
+				ImportState:       true,
+				ImportStateId:     orgID + "/" + username + "@arangodb.com",
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
 
 // testAccCheckDestroyOrganization verifies the Terraform oasis_organization_invite resource cleanup.
 func testAccCheckDestroyOrganizationInvoice(s *terraform.State) error {
+	ctx := context.Background()
 	client := testAccProvider.Meta().(*Client)
-	if err := client.Connect(); err != nil {
-		client.log.Error().Err(err).Msg("Failed to connect to api")
+	if err := client.Connect(ctx); err != nil {
+		tflog.Error(ctx, "Failed to connect to api", map[string]interface{}{"error": err})
 		return err
 	}
 	rmc := rm.NewResourceManagerServiceClient(client.conn)
@@ -83,7 +92,7 @@ func testAccCheckDestroyOrganizationInvoice(s *terraform.State) error {
 			continue
 		}
 
-		if _, err := rmc.GetOrganizationInvite(client.ctxWithToken, &common.IDOptions{Id: rs.Primary.ID}); err == nil {
+		if _, err := rmc.GetOrganizationInvite(client.apiContext(ctx), &common.IDOptions{Id: rs.Primary.ID}); err == nil {
 			return fmt.Errorf("organization invite still present")
 		}
 	}
@@ -21,11 +21,13 @@
 package provider
 
 import (
+	"context"
 	"fmt"
 	"os"
 	"regexp"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -68,15 +70,21 @@ func TestAccResourceOrganization(t *testing.T) {
 					resource.TestCheckResourceAttr("oasis_organization."+res, organizationDescriptionFieldName, "A test Oasis organization from Terraform Provider"),
 				),
 			},
+			{
+				ResourceName:      "oasis_organization." + res,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
 
 // testAccCheckDestroyOrganization verifies the Terraform oasis_organization resource cleanup.
 func testAccCheckDestroyOrganization(s *terraform.State) error {
+	ctx := context.Background()
 	client := testAccProvider.Meta().(*Client)
-	if err := client.Connect(); err != nil {
-		client.log.Error().Err(err).Msg("Failed to connect to api")
+	if err := client.Connect(ctx); err != nil {
+		tflog.Error(ctx, "Failed to connect to api", map[string]interface{}{"error": err})
 		return err
 	}
 	rmc := rm.NewResourceManagerServiceClient(client.conn)
@@ -86,7 +94,7 @@ func testAccCheckDestroyOrganization(s *terraform.State) error {
 			continue
 		}
 
-		if _, err := rmc.GetOrganization(client.ctxWithToken, &common.IDOptions{Id: rs.Primary.ID}); err == nil {
+		if _, err := rmc.GetOrganization(client.apiContext(ctx), &common.IDOptions{Id: rs.Primary.ID}); err == nil {
 			return fmt.Errorf("organization still present")
 		}
 	}
@@ -24,6 +24,7 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
@@ -64,6 +65,15 @@ func resourcePrivateEndpoint() *schema.Resource {
 		ReadContext:   resourcePrivateEndpointRead,
 		UpdateContext: resourcePrivateEndpointUpdate,
 		DeleteContext: resourcePrivateEndpointDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(defaultOperationTimeout),
+			Read:   schema.DefaultTimeout(readOperationTimeout),
+			Update: schema.DefaultTimeout(defaultOperationTimeout),
+			Delete: schema.DefaultTimeout(defaultOperationTimeout),
+		},
 		Schema: map[string]*schema.Schema{
 			privateEndpointNameFieldName: {
 				Type:        schema.TypeString,
@@ -181,16 +191,20 @@ func resourcePrivateEndpoint() *schema.Resource {
 // resourcePrivateEndpointRead will gather information from the Terraform store for Private Endpoint resource and display it accordingly.
 func resourcePrivateEndpointRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	client := m.(*Client)
-	if err := client.Connect(); err != nil {
-		client.log.Error().Err(err).Msg("Failed to connect to api")
+	if err := client.Connect(ctx); err != nil {
+		tflog.Error(ctx, "Failed to connect to api", map[string]interface{}{"error": err})
 		return diag.FromErr(err)
 	}
 
 	nwc := network.NewNetworkServiceClient(client.conn)
-	privateEndpoint, err := nwc.GetPrivateEndpointService(client.ctxWithToken, &common.IDOptions{Id: d.Id()})
-	if err != nil || privateEndpoint == nil {
-		client.log.Error().Err(err).Str("private-endpoint-id", d.Id()).Msg("Failed to find Private Endpoint")
+	privateEndpoint, err := nwc.GetPrivateEndpointService(client.apiContext(ctx), &common.IDOptions{Id: d.Id()})
+	if isRemoved(privateEndpoint, err) {
+		tflog.Warn(ctx, "Private endpoint has been deleted, removing it from state", map[string]interface{}{"private-endpoint-id": d.Id()})
 		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		tflog.Error(ctx, "Failed to find private endpoint", map[string]interface{}{"error": err, "private-endpoint-id": d.Id()})
 		return diag.FromErr(err)
 	}
 
@@ -244,17 +258,17 @@ func flattenGCPResource(privateEndpointGCP *network.PrivateEndpointService_Gcp)
 	}
 }
 
-// flattenAWSPrincipals will take an AWS Principal Resource part of a Private Endpoint and create a sub map for terraform schema.
+// flattenAWSPrincipals will take the AWS Principals part of a Private Endpoint and create a sub map for terraform schema.
 func flattenAWSPrincipals(privateEndpointAWSPrincipals []*network.PrivateEndpointService_AwsPrincipals) []interface{} {
-	var principals = make(map[string]interface{})
+	principals := make([]interface{}, 0, len(privateEndpointAWSPrincipals))
 	for _, principal := range privateEndpointAWSPrincipals {
-		principals[privateEndpointAWSPrincipalAccountIdFieldName] = principal.GetAccountId()
-		principals[privateEndpointAWSPrincipalRoleNamesFieldName] = principal.GetRoleNames()
-		principals[privateEndpointAWSPrincipalUserNamesFieldName] = principal.GetUserNames()
-	}
-	return []interface{}{
-		principals,
+		principals = append(principals, map[string]interface{}{
+			privateEndpointAWSPrincipalAccountIdFieldName: principal.GetAccountId(),
+			privateEndpointAWSPrincipalRoleNamesFieldName: principal.GetRoleNames(),
+			privateEndpointAWSPrincipalUserNamesFieldName: principal.GetUserNames(),
+		})
 	}
+	return principals
 }
 
 // resourcePrivateEndpointCreate will take the schema data from the Terraform config file and call the Oasis client
@@ -262,8 +276,8 @@ func flattenAWSPrincipals(privateEndpointAWSPrincipals []*network.PrivateEndpoin
 // in order to create this object.
 func resourcePrivateEndpointCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	client := m.(*Client)
-	if err := client.Connect(); err != nil {
-		client.log.Error().Err(err).Msg("Failed to connect to api")
+	if err := client.Connect(ctx); err != nil {
+		tflog.Error(ctx, "Failed to connect to api", map[string]interface{}{"error": err})
 		return diag.FromErr(err)
 	}
 	nwc := network.NewNetworkServiceClient(client.conn)
@@ -272,9 +286,9 @@ func resourcePrivateEndpointCreate(ctx context.Context, d *schema.ResourceData,
 		return diag.FromErr(err)
 	}
 
-	result, err := nwc.CreatePrivateEndpointService(client.ctxWithToken, expanded)
+	result, err := nwc.CreatePrivateEndpointService(client.apiContext(ctx), expanded)
 	if err != nil {
-		client.log.Error().Err(err).Msg("Failed to create private endpoint service")
+		tflog.Error(ctx, "Failed to create private endpoint service", map[string]interface{}{"error": err})
 		return diag.FromErr(err)
 	}
 
@@ -446,16 +460,19 @@ func resourcePrivateEndpointDelete(ctx context.Context, d *schema.ResourceData,
 // resourcePrivateEndpointUpdate will take a resource diff and apply changes accordingly if there are any.
 func resourcePrivateEndpointUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	client := m.(*Client)
-	if err := client.Connect(); err != nil {
-		client.log.Error().Err(err).Msg("Failed to connect to api")
+	if err := client.Connect(ctx); err != nil {
+		tflog.Error(ctx, "Failed to connect to api", map[string]interface{}{"error": err})
 		return diag.FromErr(err)
 	}
 
 	nwc := network.NewNetworkServiceClient(client.conn)
-	privateEndpoint, err := nwc.GetPrivateEndpointService(client.ctxWithToken, &common.IDOptions{Id: d.Id()})
+	privateEndpoint, err := nwc.GetPrivateEndpointService(client.apiContext(ctx), &common.IDOptions{Id: d.Id()})
+	if isRemoved(privateEndpoint, err) {
+		tflog.Warn(ctx, "Private endpoint has been deleted, it is removed from state by the next refresh", map[string]interface{}{"private-endpoint-id": d.Id()})
+		return diag.Errorf("private endpoint %s has been deleted", d.Id())
+	}
 	if err != nil {
-		client.log.Error().Err(err).Msg("Failed to find Private Endpoint")
-		d.SetId("")
+		tflog.Error(ctx, "Failed to find Private Endpoint", map[string]interface{}{"error": err, "private-endpoint-id": d.Id()})
 		return diag.FromErr(err)
 	}
 	// Main fields
@@ -497,9 +514,9 @@ func resourcePrivateEndpointUpdate(ctx context.Context, d *schema.ResourceData,
 		privateEndpoint.Gcp = gcpResource
 	}
 
-	_, err = nwc.UpdatePrivateEndpointService(client.ctxWithToken, privateEndpoint)
+	_, err = nwc.UpdatePrivateEndpointService(client.apiContext(ctx), privateEndpoint)
 	if err != nil {
-		client.log.Error().Err(err).Msg("Failed to update Private Endpoint")
+		tflog.Error(ctx, "Failed to update Private Endpoint", map[string]interface{}{"error": err})
 		return diag.FromErr(err)
 	}
 	return resourcePrivateEndpointRead(ctx, d, m)
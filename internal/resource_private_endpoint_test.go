@@ -70,6 +70,11 @@ func TestAccResourcePrivateEndpoint(t *testing.T) {
 					resource.TestCheckResourceAttr("oasis_private_endpoint.oasis_private_endpoint_test", privateEndpointDNSNamesFieldName+".1", "test2.example.com"),
 				),
 			},
+			{
+				ResourceName:      "oasis_private_endpoint.oasis_private_endpoint_test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
@@ -112,9 +117,7 @@ func TestFlattenPrivateEndpoint(t *testing.T) {
 		}
 		expected[privateEndpointAKSFieldName] = expectedAks
 		expected[privateEndpointAWSFieldName] = []interface{}{map[string]interface{}{
-			privateEndpointAWSPrincipalFieldName: []interface{}{
-				map[string]interface{}{},
-			},
+			privateEndpointAWSPrincipalFieldName: []interface{}{},
 		}}
 		var projects []string
 		expected[privateEndpointGCPFieldName] = []interface{}{map[string]interface{}{
@@ -177,9 +180,7 @@ func TestFlattenPrivateEndpoint(t *testing.T) {
 		}
 		expected[privateEndpointGCPFieldName] = expectedGcp
 		expected[privateEndpointAWSFieldName] = []interface{}{map[string]interface{}{
-			privateEndpointAWSPrincipalFieldName: []interface{}{
-				map[string]interface{}{},
-			},
+			privateEndpointAWSPrincipalFieldName: []interface{}{},
 		}}
 		var subIDs []string
 		expected[privateEndpointAKSFieldName] = []interface{}{map[string]interface{}{
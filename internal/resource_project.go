@@ -25,6 +25,7 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
@@ -51,6 +52,15 @@ func resourceProject() *schema.Resource {
 		ReadContext:   resourceProjectRead,
 		UpdateContext: resourceProjectUpdate,
 		DeleteContext: resourceProjectDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(defaultOperationTimeout),
+			Read:   schema.DefaultTimeout(readOperationTimeout),
+			Update: schema.DefaultTimeout(defaultOperationTimeout),
+			Delete: schema.DefaultTimeout(defaultOperationTimeout),
+		},
 
 		Schema: map[string]*schema.Schema{
 			projectNameFieldName: {
@@ -98,8 +108,8 @@ func resourceProject() *schema.Resource {
 // sets the ID of a given Project once the creation is successful. This will be stored in local terraform store.
 func resourceProjectCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	client := m.(*Client)
-	if err := client.Connect(); err != nil {
-		client.log.Error().Err(err).Msg("Failed to connect to api")
+	if err := client.Connect(ctx); err != nil {
+		tflog.Error(ctx, "Failed to connect to api", map[string]interface{}{"error": err})
 		return diag.FromErr(err)
 	}
 	rmc := rm.NewResourceManagerServiceClient(client.conn)
@@ -107,9 +117,9 @@ func resourceProjectCreate(ctx context.Context, d *schema.ResourceData, m interf
 	if err != nil {
 		return diag.FromErr(err)
 	}
-	result, err := rmc.CreateProject(client.ctxWithToken, expanded)
+	result, err := rmc.CreateProject(client.apiContext(ctx), expanded)
 	if err != nil {
-		client.log.Error().Err(err).Msg("Failed to create project")
+		tflog.Error(ctx, "Failed to create project", map[string]interface{}{"error": err})
 		return diag.FromErr(err)
 	}
 	if result != nil {
@@ -155,23 +165,22 @@ func flattenProjectResource(project *rm.Project) map[string]interface{} {
 // resourceProjectRead handles the read lifecycle of the project resource.
 func resourceProjectRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	client := m.(*Client)
-	if err := client.Connect(); err != nil {
-		client.log.Error().Err(err).Msg("Failed to connect to api")
+	if err := client.Connect(ctx); err != nil {
+		tflog.Error(ctx, "Failed to connect to api", map[string]interface{}{"error": err})
 		return diag.FromErr(err)
 	}
 
 	rmc := rm.NewResourceManagerServiceClient(client.conn)
-	p, err := rmc.GetProject(client.ctxWithToken, &common.IDOptions{Id: d.Id()})
-	if err != nil {
-		client.log.Error().Err(err).Str("project-id", d.Id()).Msg("Failed to find project")
-		d.SetId("")
-		return diag.FromErr(err)
-	}
-	if p == nil {
-		client.log.Error().Str("project-id", d.Id()).Msg("Failed to find project")
+	p, err := rmc.GetProject(client.apiContext(ctx), &common.IDOptions{Id: d.Id()})
+	if isRemoved(p, err) {
+		tflog.Warn(ctx, "Project has been deleted, removing it from state", map[string]interface{}{"project-id": d.Id()})
 		d.SetId("")
 		return nil
 	}
+	if err != nil {
+		tflog.Error(ctx, "Failed to find project", map[string]interface{}{"error": err, "project-id": d.Id()})
+		return diag.FromErr(err)
+	}
 
 	for k, v := range flattenProjectResource(p) {
 		if err := d.Set(k, v); err != nil {
@@ -184,14 +193,14 @@ func resourceProjectRead(ctx context.Context, d *schema.ResourceData, m interfac
 // resourceProjectDelete will be called once the resource is destroyed.
 func resourceProjectDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	client := m.(*Client)
-	if err := client.Connect(); err != nil {
-		client.log.Error().Err(err).Msg("Failed to connect to api")
+	if err := client.Connect(ctx); err != nil {
+		tflog.Error(ctx, "Failed to connect to api", map[string]interface{}{"error": err})
 		return diag.FromErr(err)
 	}
 
 	rmc := rm.NewResourceManagerServiceClient(client.conn)
-	if _, err := rmc.DeleteProject(client.ctxWithToken, &common.IDOptions{Id: d.Id()}); err != nil {
-		client.log.Error().Err(err).Str("project-id", d.Id()).Msg("Failed to delete project")
+	if _, err := rmc.DeleteProject(client.apiContext(ctx), &common.IDOptions{Id: d.Id()}); err != nil {
+		tflog.Error(ctx, "Failed to delete project", map[string]interface{}{"error": err, "project-id": d.Id()})
 		return diag.FromErr(err)
 	}
 	d.SetId("") // called automatically, but added to be explicit
@@ -201,15 +210,15 @@ func resourceProjectDelete(ctx context.Context, d *schema.ResourceData, m interf
 // resourceProjectUpdate handles the update lifecycle of the project resource.
 func resourceProjectUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	client := m.(*Client)
-	if err := client.Connect(); err != nil {
-		client.log.Error().Err(err).Msg("Failed to connect to api")
+	if err := client.Connect(ctx); err != nil {
+		tflog.Error(ctx, "Failed to connect to api", map[string]interface{}{"error": err})
 		return diag.FromErr(err)
 	}
 
 	rmc := rm.NewResourceManagerServiceClient(client.conn)
-	p, err := rmc.GetProject(client.ctxWithToken, &common.IDOptions{Id: d.Id()})
+	p, err := rmc.GetProject(client.apiContext(ctx), &common.IDOptions{Id: d.Id()})
 	if err != nil {
-		client.log.Error().Err(err).Str("project-id", d.Id()).Msg("Failed get project")
+		tflog.Error(ctx, "Failed get project", map[string]interface{}{"error": err, "project-id": d.Id()})
 		return diag.FromErr(err)
 	}
 
@@ -222,9 +231,9 @@ func resourceProjectUpdate(ctx context.Context, d *schema.ResourceData, m interf
 	if d.HasChange(projectLockedFieldName) {
 		p.Locked = d.Get(projectLockedFieldName).(bool)
 	}
-	res, err := rmc.UpdateProject(client.ctxWithToken, p)
+	res, err := rmc.UpdateProject(client.apiContext(ctx), p)
 	if err != nil {
-		client.log.Error().Err(err).Str("project-id", d.Id()).Msg("Failed to update project")
+		tflog.Error(ctx, "Failed to update project", map[string]interface{}{"error": err, "project-id": d.Id()})
 		return diag.FromErr(err)
 	}
 	d.SetId(res.Id)
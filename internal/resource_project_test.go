@@ -21,6 +21,7 @@
 package provider
 
 import (
+	"context"
 	"fmt"
 	"os"
 	"regexp"
@@ -29,6 +30,7 @@ import (
 
 	"google.golang.org/protobuf/types/known/timestamppb"
 
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -72,6 +74,11 @@ func TestResourceCreateProject(t *testing.T) {
 					resource.TestCheckResourceAttr("oasis_project."+res, projectNameFieldName, name),
 				),
 			},
+			{
+				ResourceName:      "oasis_project." + res,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
@@ -115,9 +122,10 @@ func TestExpandingProjectResource(t *testing.T) {
 }
 
 func testAccCheckDestroyProject(s *terraform.State) error {
+	ctx := context.Background()
 	client := testAccProvider.Meta().(*Client)
-	if err := client.Connect(); err != nil {
-		client.log.Error().Err(err).Msg("Failed to connect to api")
+	if err := client.Connect(ctx); err != nil {
+		tflog.Error(ctx, "Failed to connect to api", map[string]interface{}{"error": err})
 		return err
 	}
 	rmc := rm.NewResourceManagerServiceClient(client.conn)
@@ -127,7 +135,7 @@ func testAccCheckDestroyProject(s *terraform.State) error {
 			continue
 		}
 
-		if _, err := rmc.GetProject(client.ctxWithToken, &common.IDOptions{Id: rs.Primary.ID}); !common.IsNotFound(err) {
+		if _, err := rmc.GetProject(client.apiContext(ctx), &common.IDOptions{Id: rs.Primary.ID}); !common.IsNotFound(err) {
 			return fmt.Errorf("project still present")
 		}
 	}
@@ -0,0 +1,78 @@
+//
+// DISCLAIMER
+//
+// Copyright 2024 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	// longOperationTimeout is the default timeout of changes to deployments, backups and notebooks
+	longOperationTimeout = 60 * time.Minute
+	// defaultOperationTimeout is the default timeout of changes to most other resources
+	defaultOperationTimeout = 10 * time.Minute
+	// shortOperationTimeout is the default timeout of changes to IAM resources and IP allowlists
+	shortOperationTimeout = 5 * time.Minute
+	// readOperationTimeout is the default timeout of reading any resource
+	readOperationTimeout = 5 * time.Minute
+)
+
+// operationVerbs describes the operations in diagnostics, keyed by their timeout key
+var operationVerbs = map[string]string{
+	schema.TimeoutCreate: "creating",
+	schema.TimeoutRead:   "reading",
+	schema.TimeoutUpdate: "updating",
+	schema.TimeoutDelete: "deleting",
+}
+
+// reportTimeout replaces the diagnostics of an operation which did not complete within its
+// timeout with a single diagnostic explaining which timeout to increase.
+func reportTimeout(name, operation string, op resourceOperationFunc) resourceOperationFunc {
+	if op == nil {
+		return nil
+	}
+	return func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+		diags := op(ctx, d, m)
+		if !diags.HasError() || !errors.Is(ctx.Err(), context.DeadlineExceeded) {
+			return diags
+		}
+		var causes []string
+		for _, diagnostic := range diags {
+			if diagnostic.Severity == diag.Error {
+				causes = append(causes, diagnostic.Summary)
+			}
+		}
+		return diag.Diagnostics{{
+			Severity: diag.Error,
+			Summary:  fmt.Sprintf("Timeout while %s %s", operationVerbs[operation], name),
+			Detail: fmt.Sprintf("The operation did not complete within %s (last error: %s). "+
+				"Set a longer %q timeout in the timeouts block of the resource to allow more time.",
+				d.Timeout(operation), strings.Join(causes, "; "), operation),
+		}}
+	}
+}
@@ -0,0 +1,65 @@
+//
+// DISCLAIMER
+//
+// Copyright 2024 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReportTimeout(t *testing.T) {
+	r := &schema.Resource{Schema: map[string]*schema.Schema{}}
+	op := reportTimeout("oasis_test", schema.TimeoutCreate, func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+		<-ctx.Done()
+		return diag.FromErr(errors.New("rpc error: code = DeadlineExceeded desc = context deadline exceeded"))
+	})
+	d := r.TestResourceData()
+	d.SetId("test-id")
+
+	t.Run("Deadline exceeded", func(t *testing.T) {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Millisecond)
+		defer cancel()
+		diags := op(ctx, d, nil)
+		require.Len(t, diags, 1)
+		assert.Equal(t, "Timeout while creating oasis_test", diags[0].Summary)
+		assert.Contains(t, diags[0].Detail, d.Timeout(schema.TimeoutCreate).String())
+		assert.Contains(t, diags[0].Detail, "context deadline exceeded")
+		assert.Contains(t, diags[0].Detail, `"create" timeout`)
+	})
+
+	t.Run("Other errors", func(t *testing.T) {
+		failing := reportTimeout("oasis_test", schema.TimeoutCreate, func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+			return diag.FromErr(errors.New("permission denied"))
+		})
+		diags := failing(context.Background(), d, nil)
+		require.Len(t, diags, 1)
+		assert.Equal(t, "permission denied", diags[0].Summary)
+	})
+
+	assert.Nil(t, reportTimeout("oasis_test", schema.TimeoutUpdate, nil))
+}
@@ -0,0 +1,194 @@
+//
+// DISCLAIMER
+//
+// Copyright 2024 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// tracerName is the name of the tracer creating all spans of the provider
+	tracerName = "github.com/arangodb-managed/terraform-provider-oasis"
+	// serviceName is the default service name of the exported spans, overridden by OTEL_SERVICE_NAME
+	serviceName = "terraform-provider-oasis"
+
+	// OTLP protocols spans can be exported with
+	otlpProtocolGRPC         = "grpc"
+	otlpProtocolHTTPProtobuf = "http/protobuf"
+)
+
+// ConfigureTracing sets up the export of spans with the standard OTEL_* environment variables.
+// Spans are only exported when an OTLP endpoint is set with OTEL_EXPORTER_OTLP_ENDPOINT or
+// OTEL_EXPORTER_OTLP_TRACES_ENDPOINT. The returned function flushes and stops the export.
+func ConfigureTracing(ctx context.Context) (func(context.Context) error, error) {
+	noop := func(context.Context) error { return nil }
+	if !tracingEnabled() {
+		return noop, nil
+	}
+	protocol, err := otlpTracesProtocol()
+	if err != nil {
+		return noop, err
+	}
+
+	var exporter sdktrace.SpanExporter
+	if protocol == otlpProtocolGRPC {
+		exporter, err = otlptracegrpc.New(ctx)
+	} else {
+		exporter, err = otlptracehttp.New(ctx)
+	}
+	if err != nil {
+		return noop, fmt.Errorf("failed to create OTLP trace exporter: %w", err)
+	}
+	// Attributes detected later take precedence, so OTEL_SERVICE_NAME and
+	// OTEL_RESOURCE_ATTRIBUTES override the default service name.
+	res, err := resource.New(ctx,
+		resource.WithAttributes(attribute.String("service.name", serviceName)),
+		resource.WithTelemetrySDK(),
+		resource.WithFromEnv(),
+	)
+	if err != nil {
+		return noop, fmt.Errorf("failed to create OTel resource: %w", err)
+	}
+	tp := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(res),
+	)
+	otel.SetTracerProvider(tp)
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(propagation.TraceContext{}, propagation.Baggage{}))
+	return tp.Shutdown, nil
+}
+
+// otlpTracesProtocol returns the OTLP protocol spans are exported with, which is set with
+// OTEL_EXPORTER_OTLP_TRACES_PROTOCOL or OTEL_EXPORTER_OTLP_PROTOCOL and defaults to http/protobuf.
+func otlpTracesProtocol() (string, error) {
+	for _, env := range []string{"OTEL_EXPORTER_OTLP_TRACES_PROTOCOL", "OTEL_EXPORTER_OTLP_PROTOCOL"} {
+		switch protocol := os.Getenv(env); protocol {
+		case "":
+			continue
+		case otlpProtocolGRPC, otlpProtocolHTTPProtobuf:
+			return protocol, nil
+		default:
+			return "", fmt.Errorf("unsupported %s %q: expected %s or %s", env, protocol, otlpProtocolGRPC, otlpProtocolHTTPProtobuf)
+		}
+	}
+	return otlpProtocolHTTPProtobuf, nil
+}
+
+// tracingEnabled returns true if an OTLP endpoint is configured and the SDK is not disabled.
+func tracingEnabled() bool {
+	if strings.EqualFold(os.Getenv("OTEL_SDK_DISABLED"), "true") || os.Getenv("OTEL_TRACES_EXPORTER") == "none" {
+		return false
+	}
+	return os.Getenv("OTEL_EXPORTER_OTLP_ENDPOINT") != "" || os.Getenv("OTEL_EXPORTER_OTLP_TRACES_ENDPOINT") != ""
+}
+
+// tracer returns the tracer of the provider.
+func tracer() trace.Tracer {
+	return otel.Tracer(tracerName)
+}
+
+// traceOperation wraps a resource operation in a span named after the resource type and
+// operation, e.g. oasis_deployment.Create.
+// A trace context passed to Terraform in the TRACEPARENT environment variable becomes its parent.
+func traceOperation(name, operation string, op resourceOperationFunc) resourceOperationFunc {
+	if op == nil {
+		return nil
+	}
+	name = name + "." + strings.ToUpper(operation[:1]) + operation[1:]
+	return func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+		if !trace.SpanContextFromContext(ctx).IsValid() {
+			ctx = otel.GetTextMapPropagator().Extract(ctx, propagation.MapCarrier{
+				"traceparent": os.Getenv("TRACEPARENT"),
+				"tracestate":  os.Getenv("TRACESTATE"),
+			})
+		}
+		ctx, span := tracer().Start(ctx, name)
+		diags := op(ctx, d, m)
+		if id := d.Id(); id != "" {
+			span.SetAttributes(attribute.String("oasis.resource_id", id))
+		}
+		for _, diagnostic := range diags {
+			if diagnostic.Severity == diag.Error {
+				span.SetStatus(codes.Error, diagnostic.Summary)
+				break
+			}
+		}
+		span.End()
+		// The provider process may be stopped at any time after an operation, so spans are not kept in the batch
+		if tp, ok := otel.GetTracerProvider().(*sdktrace.TracerProvider); ok {
+			tp.ForceFlush(context.WithoutCancel(ctx))
+		}
+		return diags
+	}
+}
+
+// spanInterceptor traces every call to the Arango Graph API in a child span of the
+// current operation and propagates the trace context in the outgoing metadata.
+func (c *Client) spanInterceptor(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+	fullName := strings.TrimPrefix(method, "/")
+	service, rpcMethod := fullName, ""
+	if i := strings.LastIndex(fullName, "/"); i >= 0 {
+		service, rpcMethod = fullName[:i], fullName[i+1:]
+	}
+	ctx, span := tracer().Start(ctx, fullName,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			attribute.String("rpc.system", "grpc"),
+			attribute.String("rpc.service", service),
+			attribute.String("rpc.method", rpcMethod),
+		),
+	)
+	defer span.End()
+	if id := requestResourceID(req); id != "" {
+		span.SetAttributes(attribute.String("oasis.resource_id", id))
+	}
+
+	carrier := propagation.MapCarrier{}
+	otel.GetTextMapPropagator().Inject(ctx, carrier)
+	for key, value := range carrier {
+		ctx = metadata.AppendToOutgoingContext(ctx, key, value)
+	}
+
+	err := invoker(ctx, method, req, reply, cc, opts...)
+	span.SetAttributes(attribute.Int64("rpc.grpc.status_code", int64(status.Code(err))))
+	if err != nil {
+		span.SetStatus(codes.Error, c.redact(status.Convert(err).Message()))
+	}
+	return err
+}
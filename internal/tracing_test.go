@@ -0,0 +1,140 @@
+//
+// DISCLAIMER
+//
+// Copyright 2024 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	common "github.com/arangodb-managed/apis/common/v1"
+)
+
+// TestOTLPTracesProtocol tests the selection of the OTLP protocol from the environment.
+func TestOTLPTracesProtocol(t *testing.T) {
+	t.Setenv("OTEL_EXPORTER_OTLP_TRACES_PROTOCOL", "")
+	t.Setenv("OTEL_EXPORTER_OTLP_PROTOCOL", "")
+	protocol, err := otlpTracesProtocol()
+	require.NoError(t, err)
+	assert.Equal(t, otlpProtocolHTTPProtobuf, protocol)
+
+	t.Setenv("OTEL_EXPORTER_OTLP_PROTOCOL", "grpc")
+	protocol, err = otlpTracesProtocol()
+	require.NoError(t, err)
+	assert.Equal(t, otlpProtocolGRPC, protocol)
+
+	// The protocol for traces takes precedence
+	t.Setenv("OTEL_EXPORTER_OTLP_TRACES_PROTOCOL", "http/protobuf")
+	protocol, err = otlpTracesProtocol()
+	require.NoError(t, err)
+	assert.Equal(t, otlpProtocolHTTPProtobuf, protocol)
+
+	t.Setenv("OTEL_EXPORTER_OTLP_TRACES_PROTOCOL", "http/json")
+	_, err = otlpTracesProtocol()
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), `unsupported OTEL_EXPORTER_OTLP_TRACES_PROTOCOL "http/json"`)
+}
+
+// recordSpans installs a tracer provider recording all ended spans for the duration of the test.
+func recordSpans(t *testing.T) *tracetest.SpanRecorder {
+	recorder := tracetest.NewSpanRecorder()
+	prevProvider, prevPropagator := otel.GetTracerProvider(), otel.GetTextMapPropagator()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)))
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+	t.Cleanup(func() {
+		otel.SetTracerProvider(prevProvider)
+		otel.SetTextMapPropagator(prevPropagator)
+	})
+	return recorder
+}
+
+func TestTraceOperation(t *testing.T) {
+	recorder := recordSpans(t)
+	resources := map[string]*schema.Resource{
+		"oasis_test": {
+			Schema: map[string]*schema.Schema{},
+			CreateContext: func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+				d.SetId("test-id")
+				return nil
+			},
+			ReadContext: func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+				return diag.FromErr(errors.New("read failed"))
+			},
+		},
+	}
+	wrapResourceOperations("data.", resources, traceOperation)
+	r := resources["oasis_test"]
+	assert.Nil(t, r.UpdateContext)
+	assert.Nil(t, r.CreateWithoutTimeout)
+
+	d := r.TestResourceData()
+	assert.False(t, r.CreateContext(context.Background(), d, nil).HasError())
+	assert.True(t, r.ReadContext(context.Background(), d, nil).HasError())
+
+	spans := recorder.Ended()
+	require.Len(t, spans, 2)
+	assert.Equal(t, "data.oasis_test.Create", spans[0].Name())
+	assert.Equal(t, codes.Unset, spans[0].Status().Code)
+	assert.Contains(t, spans[0].Attributes(), attribute.String("oasis.resource_id", "test-id"))
+	assert.Equal(t, "data.oasis_test.Read", spans[1].Name())
+	assert.Equal(t, codes.Error, spans[1].Status().Code)
+	assert.Equal(t, "read failed", spans[1].Status().Description)
+}
+
+func TestSpanInterceptor(t *testing.T) {
+	recorder := recordSpans(t)
+	ctx, parent := otel.Tracer("test").Start(context.Background(), "oasis_deployment.Create")
+	client := &Client{}
+
+	var traceparent []string
+	invoker := func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, opts ...grpc.CallOption) error {
+		md, _ := metadata.FromOutgoingContext(ctx)
+		traceparent = md.Get("traceparent")
+		return nil
+	}
+	err := client.spanInterceptor(ctx, "/arangodb.cloud.data.v1.DataService/GetDeployment", &common.IDOptions{Id: "depl-id"}, &common.Empty{}, nil, invoker)
+	require.NoError(t, err)
+	parent.End()
+
+	spans := recorder.Ended()
+	require.Len(t, spans, 2)
+	call := spans[0]
+	assert.Equal(t, "arangodb.cloud.data.v1.DataService/GetDeployment", call.Name())
+	assert.Equal(t, parent.SpanContext().SpanID(), call.Parent().SpanID())
+	assert.Contains(t, call.Attributes(), attribute.String("rpc.service", "arangodb.cloud.data.v1.DataService"))
+	assert.Contains(t, call.Attributes(), attribute.String("rpc.method", "GetDeployment"))
+	assert.Contains(t, call.Attributes(), attribute.String("oasis.resource_id", "depl-id"))
+	require.Len(t, traceparent, 1)
+	assert.Contains(t, traceparent[0], call.SpanContext().SpanID().String())
+}
@@ -22,6 +22,9 @@
 package main
 
 import (
+	"context"
+	"log"
+
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/plugin"
 
@@ -41,6 +44,11 @@ var (
 
 func main() {
 	provider.SetVersionAndBuild(projectVersion, projectBuild)
+	shutdownTracing, err := provider.ConfigureTracing(context.Background())
+	if err != nil {
+		log.Printf("[WARN] Tracing disabled: %s", err)
+	}
+	defer shutdownTracing(context.Background())
 	plugin.Serve(&plugin.ServeOpts{
 		ProviderFunc: func() *schema.Provider {
 			return provider.Provider()